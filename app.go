@@ -3,19 +3,73 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
+	"property-management/internal/auth"
+	"property-management/internal/configuration"
 	"property-management/internal/db"
+	"property-management/internal/dump"
+	"property-management/internal/guides"
+	"property-management/internal/mailer"
+	"property-management/internal/merge"
 	"property-management/internal/models"
 	"property-management/internal/repository"
+	"property-management/internal/scheduler"
+	"property-management/internal/vault"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
 // App struct represents the application
 type App struct {
-	ctx             context.Context
-	db              *sql.DB
-	houseRepository *repository.HouseRepository
+	ctx                       context.Context
+	db                        *sql.DB
+	houseRepository           *repository.HouseRepository
+	propertyManagerRepository *repository.PropertyManagerRepository
+	meterRepository           *repository.MeterRepository
+	meterReadingRepository    *repository.MeterReadingRepository
+	loanScenarioRepository    *repository.LoanScenarioRepository
+	settingsRepository        *repository.SettingsRepository
+	emailLogRepository        *repository.EmailLogRepository
+	documentRepository        *repository.DocumentRepository
+	integrityCheckRepository  *repository.IntegrityCheckRepository
+	featureFlagRepository     *repository.FeatureFlagRepository
+	userRepository            *repository.UserRepository
+	backupRunRepository       *repository.BackupRunRepository
+	currentUserID             int64
+	changeWatcher             *db.ChangeWatcher
+	documentVault             *vault.Vault
+	stopJobs                  context.CancelFunc
+
+	// mu guards the connection, the repositories bound to it, the change
+	// watcher and the session. Methods and background jobs hold the read lock
+	// while they work; swapping the database or the session takes the write lock.
+	mu sync.RWMutex
 }
 
+// integrityCheckInterval is how often the database and newest backup are verified
+const integrityCheckInterval = 7 * 24 * time.Hour
+
+// integrityCheckFailedEvent is emitted to the frontend when a scheduled check fails
+const integrityCheckFailedEvent = "integrity-check-failed"
+
+// backupFailedEvent is emitted to the frontend when a scheduled backup fails
+const backupFailedEvent = "backup-failed"
+
+// externalChangeInterval is how often the database file is checked for writes by other instances
+const externalChangeInterval = 5 * time.Second
+
+// externalChangeEvent tells the frontend to reload because another instance changed the data
+const externalChangeEvent = "database-changed-externally"
+
 // NewApp creates a new App application struct
 func NewApp() *App {
 	return &App{}
@@ -25,15 +79,293 @@ func NewApp() *App {
 // so we can call the runtime methods
 func (a *App) startup(ctx context.Context) {
 	a.ctx = ctx
-	a.db = db.GetDB()
+	a.mu.Lock()
+	a.setDB(db.GetDB())
+	a.mu.Unlock()
+	a.documentVault = vault.New(filepath.Join(db.DataDir(), "documents"))
+
+	// Start background jobs; they check hourly whether they are due. The
+	// integrity check verifies the newest backup, so it waits for the first
+	// backup job to have taken any backup that is due.
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	a.stopJobs = stopJobs
+	backupStarted := scheduler.Start(jobsCtx, time.Hour, a.runScheduledBackup)
+	go func() {
+		select {
+		case <-backupStarted:
+			scheduler.Start(jobsCtx, time.Hour, a.runScheduledIntegrityCheck)
+		case <-jobsCtx.Done():
+		}
+	}()
+	scheduler.Start(jobsCtx, externalChangeInterval, a.checkExternalChanges)
+}
+
+// setDB binds all repositories to the given database connection; callers
+// must hold the write lock
+func (a *App) setDB(conn *sql.DB) {
+	a.db = conn
 	a.houseRepository = repository.NewHouseRepository(a.db)
+	a.propertyManagerRepository = repository.NewPropertyManagerRepository(a.db)
+	a.meterRepository = repository.NewMeterRepository(a.db)
+	a.meterReadingRepository = repository.NewMeterReadingRepository(a.db)
+	a.loanScenarioRepository = repository.NewLoanScenarioRepository(a.db)
+	a.settingsRepository = repository.NewSettingsRepository(a.db)
+	a.emailLogRepository = repository.NewEmailLogRepository(a.db)
+	a.documentRepository = repository.NewDocumentRepository(a.db)
+	a.integrityCheckRepository = repository.NewIntegrityCheckRepository(a.db)
+	a.featureFlagRepository = repository.NewFeatureFlagRepository(a.db)
+	a.userRepository = repository.NewUserRepository(a.db)
+	a.backupRunRepository = repository.NewBackupRunRepository(a.db)
+
+	watcher, err := db.NewChangeWatcher(a.db)
+	if err != nil {
+		log.Printf("Detecting changes by other instances is disabled: %v", err)
+	}
+	a.changeWatcher = watcher
+}
+
+// parseDate parses a YYYY-MM-DD date coming from the frontend
+func parseDate(value, field string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q: expected YYYY-MM-DD", field, value)
+	}
+	return date, nil
 }
 
 // shutdown is called when the app is closing
 func (a *App) shutdown(ctx context.Context) {
+	if a.stopJobs != nil {
+		a.stopJobs()
+	}
+
+	// Wait for running calls and jobs before closing the connection
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	db.Close()
 }
 
+// errNotLoggedIn is returned by guarded methods until a user has logged in
+var errNotLoggedIn = errors.New("please log in first")
+
+// authorize returns an error unless the logged-in user's role grants the
+// permission. Installations without user accounts run in single-user mode,
+// where everything is allowed.
+func (a *App) authorize(permission models.Permission) error {
+	count, err := a.userRepository.Count()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return nil
+	}
+
+	if a.currentUserID == 0 {
+		return errNotLoggedIn
+	}
+	user, err := a.userRepository.GetByID(a.currentUserID)
+	if err != nil {
+		return errNotLoggedIn
+	}
+
+	if !user.Role.Can(permission) {
+		return fmt.Errorf("the %s role does not allow this action", user.Role)
+	}
+	return nil
+}
+
+// IsLoginRequired reports whether user accounts exist, so the frontend has to
+// show the login screen at startup
+func (a *App) IsLoginRequired() (bool, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	count, err := a.userRepository.Count()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Login starts a session for the user with the given credentials
+func (a *App) Login(username, password string) (*models.User, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	user, err := a.userRepository.GetByUsername(username)
+	if err != nil {
+		return nil, errors.New("invalid username or password")
+	}
+
+	ok, err := auth.CheckPassword(user.PasswordHash, password)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("invalid username or password")
+	}
+
+	a.currentUserID = user.ID
+	return user, nil
+}
+
+// Logout ends the current session
+func (a *App) Logout() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.currentUserID = 0
+}
+
+// GetCurrentUser returns the logged-in user, or nil if nobody is logged in
+func (a *App) GetCurrentUser() (*models.User, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.currentUser(), nil
+}
+
+// currentUser returns the logged-in user, or nil if nobody is logged in or
+// the account no longer exists
+func (a *App) currentUser() *models.User {
+	if a.currentUserID == 0 {
+		return nil
+	}
+
+	user, err := a.userRepository.GetByID(a.currentUserID)
+	if err != nil {
+		return nil
+	}
+	return user
+}
+
+// CreateUser adds a user account. The first account must be an administrator
+// and ends single-user mode; its creator is logged in as that account.
+func (a *App) CreateUser(username, password, role string) (*models.User, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	firstUser, err := a.userRepository.Count()
+	if err != nil {
+		return nil, err
+	}
+
+	user := models.NewUser(username, models.Role(role))
+	user.PasswordHash = hash
+	if err := a.userRepository.Create(user); err != nil {
+		return nil, err
+	}
+
+	if firstUser == 0 {
+		a.currentUserID = user.ID
+	}
+	return user, nil
+}
+
+// GetUsers returns all user accounts
+func (a *App) GetUsers() ([]models.User, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	return a.userRepository.GetAll()
+}
+
+// UpdateUserRole changes the role of a user account
+func (a *App) UpdateUserRole(id int64, role string) (*models.User, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	if err := a.userRepository.UpdateRole(id, models.Role(role)); err != nil {
+		return nil, err
+	}
+
+	return a.userRepository.GetByID(id)
+}
+
+// ResetUserPassword sets a new password for another user, for example after they forgot theirs
+func (a *App) ResetUserPassword(id int64, password string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return a.userRepository.UpdatePassword(id, hash)
+}
+
+// ChangePassword replaces the logged-in user's password after confirming the current one
+func (a *App) ChangePassword(currentPassword, newPassword string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	user := a.currentUser()
+	if user == nil {
+		return errNotLoggedIn
+	}
+
+	ok, err := auth.CheckPassword(user.PasswordHash, currentPassword)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("current password is incorrect")
+	}
+
+	hash, err := auth.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	return a.userRepository.UpdatePassword(user.ID, hash)
+}
+
+// DeleteUser removes a user account; deleting your own account logs you out
+func (a *App) DeleteUser(id int64) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	if err := a.userRepository.Delete(id); err != nil {
+		return err
+	}
+
+	if id == a.currentUserID {
+		a.currentUserID = 0
+	}
+	return nil
+}
+
+// GetGuides returns the step-by-step workflow guides shipped with this version
+func (a *App) GetGuides() ([]guides.Guide, error) {
+	return guides.All()
+}
+
 // GetAppInfo returns basic information about the application
 func (a *App) GetAppInfo() map[string]string {
 	return map[string]string{
@@ -45,6 +377,13 @@ func (a *App) GetAppInfo() map[string]string {
 
 // CreateHouse adds a new house to the database
 func (a *App) CreateHouse(name, street, number, country, zipCode, city string) (*models.House, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return nil, err
+	}
+
 	house := models.NewHouse(name, street, number, country, zipCode, city)
 	err := a.houseRepository.Create(house)
 	if err != nil {
@@ -55,16 +394,74 @@ func (a *App) CreateHouse(name, street, number, country, zipCode, city string) (
 
 // GetAllHouses returns all houses from the database
 func (a *App) GetAllHouses() ([]models.House, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
 	return a.houseRepository.GetAll()
 }
 
+// GetActiveHouses returns all houses that have not been sold
+func (a *App) GetActiveHouses() ([]models.House, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.houseRepository.GetActive()
+}
+
+// GetArchivedHouses returns all sold houses for historical reporting
+func (a *App) GetArchivedHouses() ([]models.House, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.houseRepository.GetArchived()
+}
+
+// GetHousesPage returns one page of houses for large portfolios; archived
+// houses are only included when requested
+func (a *App) GetHousesPage(page models.PageRequest, includeArchived bool) (*models.HousePage, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.houseRepository.GetPage(page, includeArchived)
+}
+
 // GetHouseByID returns a house with the specified ID
 func (a *App) GetHouseByID(id int64) (*models.House, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
 	return a.houseRepository.GetByID(id)
 }
 
 // UpdateHouse modifies an existing house in the database
 func (a *App) UpdateHouse(id int64, name, street, number, country, zipCode, city string) (*models.House, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return nil, err
+	}
+
 	house := &models.House{
 		ID:      id,
 		Name:    name,
@@ -80,10 +477,1224 @@ func (a *App) UpdateHouse(id int64, name, street, number, country, zipCode, city
 		return nil, err
 	}
 
+	// Reload so fields not edited here (acquisition data, sale date) are included
+	return a.houseRepository.GetByID(id)
+}
+
+// UpdateHouseAcquisition stores the acquisition data of a house
+func (a *App) UpdateHouseAcquisition(id int64, purchasePrice, incidentalCosts, landValueShare float64) (*models.House, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditFinances); err != nil {
+		return nil, err
+	}
+
+	house, err := a.houseRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	house.PurchasePrice = purchasePrice
+	house.IncidentalCosts = incidentalCosts
+	house.LandValueShare = landValueShare
+
+	err = a.houseRepository.UpdateAcquisition(house)
+	if err != nil {
+		return nil, err
+	}
+
 	return house, nil
 }
 
+// GetYieldMetrics computes gross/net initial yield and cash-on-cash return of a house.
+// Rent and cost figures are supplied by the caller until income and expenses are tracked.
+func (a *App) GetYieldMetrics(houseID int64, inputs models.YieldInputs) (*models.YieldMetrics, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	house, err := a.houseRepository.GetByID(houseID)
+	if err != nil {
+		return nil, err
+	}
+	return models.CalculateYieldMetrics(house, inputs)
+}
+
 // DeleteHouse removes a house from the database
 func (a *App) DeleteHouse(id int64) error {
-	return a.houseRepository.Delete(id)
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return err
+	}
+
+	// Deleting cascades to all of the house's records, so keep a way back
+	if _, err := db.Snapshot(a.db, fmt.Sprintf("delete house %d", id)); err != nil {
+		return err
+	}
+	defer a.pruneSnapshots()
+
+	documents, err := a.documentRepository.GetByHouseID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := a.houseRepository.Delete(id); err != nil {
+		return err
+	}
+
+	a.removeDocumentFiles(documents)
+	return nil
+}
+
+// ArchiveHouse marks a house as sold on the given date (YYYY-MM-DD), making it read-only
+func (a *App) ArchiveHouse(houseID int64, saleDate string) (*models.House, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return nil, err
+	}
+
+	date, err := parseDate(saleDate, "sale date")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.houseRepository.Archive(houseID, date); err != nil {
+		return nil, err
+	}
+
+	return a.houseRepository.GetByID(houseID)
+}
+
+// GetPropertyManager returns the external property manager of a house
+func (a *App) GetPropertyManager(houseID int64) (*models.PropertyManager, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.propertyManagerRepository.GetByHouseID(houseID)
+}
+
+// SavePropertyManager creates or replaces the external property manager of a house
+func (a *App) SavePropertyManager(houseID int64, manager models.PropertyManager) (*models.PropertyManager, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return nil, err
+	}
+
+	manager.HouseID = houseID
+	err := a.propertyManagerRepository.Save(&manager)
+	if err != nil {
+		return nil, err
+	}
+	return &manager, nil
+}
+
+// DeletePropertyManager removes the external property manager of a house
+func (a *App) DeletePropertyManager(houseID int64) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return err
+	}
+
+	return a.propertyManagerRepository.DeleteByHouseID(houseID)
+}
+
+// BackupDatabase writes a verified snapshot of the database to targetPath
+func (a *App) BackupDatabase(targetPath string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	return db.Backup(a.db, targetPath)
+}
+
+// RestoreDatabase replaces the database with the backup at sourcePath and reconnects
+func (a *App) RestoreDatabase(sourcePath string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	return a.restoreDatabase(sourcePath)
+}
+
+// restoreDatabase snapshots the current data, restores the backup and rebinds
+// the repositories; callers must hold the write lock
+func (a *App) restoreDatabase(sourcePath string) error {
+	// Snapshot the current data so the restore itself can be undone
+	if _, err := db.Snapshot(a.db, "restore"); err != nil {
+		return err
+	}
+	defer a.pruneSnapshots()
+
+	conn, err := db.Restore(sourcePath)
+	if conn != nil && conn != a.db {
+		a.setDB(conn)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The restored database has its own accounts
+	a.currentUserID = 0
+	return nil
+}
+
+// MergeDatabase adds the houses and their records from another database file
+// of this application, for example from a second computer, and reports
+// records that differ between the two. If any record cannot be added,
+// nothing is merged. A snapshot is taken first so a completed merge can be
+// rolled back.
+func (a *App) MergeDatabase(sourcePath string) (*models.MergeReport, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	// Work on a migrated copy so files from older versions can be merged
+	source, cleanup, err := db.OpenCopy(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	if _, err := db.Snapshot(a.db, "merge"); err != nil {
+		return nil, err
+	}
+	defer a.pruneSnapshots()
+
+	return merge.Merge(a.db, source)
+}
+
+// ExportAllJSON writes all houses and their records to a versioned JSON file
+func (a *App) ExportAllJSON(path string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	return dump.Write(path, a.db)
+}
+
+// ImportAllJSON adds the houses and records of a JSON dump. Records already
+// present are matched and reported like when merging a database file, and
+// as there, nothing is imported if any record cannot be added.
+func (a *App) ImportAllJSON(path string) (*models.MergeReport, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	file, err := dump.Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stage the dump in a scratch database so it is validated as a whole first
+	staging, err := db.OpenInMemory()
+	if err != nil {
+		return nil, err
+	}
+	defer staging.Close()
+
+	if err := dump.Load(staging, file); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Snapshot(a.db, "json import"); err != nil {
+		return nil, err
+	}
+	defer a.pruneSnapshots()
+
+	return merge.Merge(a.db, staging)
+}
+
+// GetSnapshots returns the snapshots taken automatically before migrations
+// and destructive operations, newest first
+func (a *App) GetSnapshots() ([]db.SnapshotInfo, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	return db.ListSnapshots()
+}
+
+// RollbackToSnapshot restores the state from before the operation that took the snapshot
+func (a *App) RollbackToSnapshot(path string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	if !db.IsSnapshot(path) {
+		return errors.New("file is not a snapshot of this database")
+	}
+	return a.restoreDatabase(path)
+}
+
+// pruneSnapshots removes old snapshots once a destructive operation has finished
+func (a *App) pruneSnapshots() {
+	if err := db.PruneSnapshots(); err != nil {
+		log.Printf("Removing old snapshots failed: %v", err)
+	}
+}
+
+// CreateMeter adds a new meter to a house
+func (a *App) CreateMeter(houseID int64, meterType, serialNumber, location, unit string) (*models.Meter, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return nil, err
+	}
+
+	meter := models.NewMeter(houseID, models.MeterType(meterType), serialNumber, location, unit)
+	err := a.meterRepository.Create(meter)
+	if err != nil {
+		return nil, err
+	}
+	return meter, nil
+}
+
+// GetMetersByHouseID returns all meters installed in a house
+func (a *App) GetMetersByHouseID(houseID int64) ([]models.Meter, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.meterRepository.GetByHouseID(houseID)
+}
+
+// UpdateMeter modifies an existing meter
+func (a *App) UpdateMeter(id int64, meterType, serialNumber, location, unit string) (*models.Meter, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return nil, err
+	}
+
+	meter, err := a.meterRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	meter.Type = models.MeterType(meterType)
+	meter.SerialNumber = serialNumber
+	meter.Location = location
+	meter.Unit = unit
+
+	err = a.meterRepository.Update(meter)
+	if err != nil {
+		return nil, err
+	}
+
+	return meter, nil
+}
+
+// DeleteMeter removes a meter together with its readings and documents
+func (a *App) DeleteMeter(id int64) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return err
+	}
+
+	documents, err := a.documentRepository.GetByEntity(models.DocumentEntityMeter, id)
+	if err != nil {
+		return err
+	}
+
+	if err := a.meterRepository.Delete(id); err != nil {
+		return err
+	}
+
+	a.removeDocumentFiles(documents)
+	return nil
+}
+
+// CreateMeterReading records a reading (date as YYYY-MM-DD) for a meter
+func (a *App) CreateMeterReading(meterID int64, readingDate string, value float64, note string) (*models.MeterReading, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditFinances); err != nil {
+		return nil, err
+	}
+
+	date, err := parseDate(readingDate, "reading date")
+	if err != nil {
+		return nil, err
+	}
+
+	reading := models.NewMeterReading(meterID, date, value, note)
+	err = a.meterReadingRepository.Create(reading)
+	if err != nil {
+		return nil, err
+	}
+	return reading, nil
+}
+
+// GetMeterReadings returns all readings of a meter ordered by date
+func (a *App) GetMeterReadings(meterID int64) ([]models.MeterReading, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.meterReadingRepository.GetByMeterID(meterID)
+}
+
+// UpdateMeterReading modifies an existing meter reading
+func (a *App) UpdateMeterReading(id int64, readingDate string, value float64, note string) (*models.MeterReading, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditFinances); err != nil {
+		return nil, err
+	}
+
+	date, err := parseDate(readingDate, "reading date")
+	if err != nil {
+		return nil, err
+	}
+
+	reading, err := a.meterReadingRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	reading.ReadingDate = date
+	reading.Value = value
+	reading.Note = note
+
+	err = a.meterReadingRepository.Update(reading)
+	if err != nil {
+		return nil, err
+	}
+
+	return reading, nil
+}
+
+// DeleteMeterReading removes a meter reading
+func (a *App) DeleteMeterReading(id int64) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditFinances); err != nil {
+		return err
+	}
+
+	return a.meterReadingRepository.Delete(id)
+}
+
+// GetMeterConsumptionDeltas returns the consumption between consecutive readings of a meter
+func (a *App) GetMeterConsumptionDeltas(meterID int64) ([]models.ConsumptionDelta, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	readings, err := a.meterReadingRepository.GetByMeterID(meterID)
+	if err != nil {
+		return nil, err
+	}
+	return models.ConsumptionDeltas(readings), nil
+}
+
+// GetMeterConsumption returns the consumption of a meter between two dates (YYYY-MM-DD)
+func (a *App) GetMeterConsumption(meterID int64, fromDate, toDate string) (float64, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return 0, err
+	}
+
+	from, err := parseDate(fromDate, "start date")
+	if err != nil {
+		return 0, err
+	}
+
+	to, err := parseDate(toDate, "end date")
+	if err != nil {
+		return 0, err
+	}
+
+	return a.meterReadingRepository.GetConsumption(meterID, from, to)
+}
+
+// CreateLoanScenario stores a refinancing scenario for a house
+func (a *App) CreateLoanScenario(houseID int64, scenario models.LoanScenario) (*models.LoanScenario, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditFinances); err != nil {
+		return nil, err
+	}
+
+	scenario.HouseID = houseID
+	err := a.loanScenarioRepository.Create(&scenario)
+	if err != nil {
+		return nil, err
+	}
+	return &scenario, nil
+}
+
+// GetLoanScenarios returns all refinancing scenarios of a house
+func (a *App) GetLoanScenarios(houseID int64) ([]models.LoanScenario, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.loanScenarioRepository.GetByHouseID(houseID)
+}
+
+// UpdateLoanScenario modifies an existing refinancing scenario
+func (a *App) UpdateLoanScenario(id int64, scenario models.LoanScenario) (*models.LoanScenario, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditFinances); err != nil {
+		return nil, err
+	}
+
+	existing, err := a.loanScenarioRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	scenario.ID = id
+	scenario.HouseID = existing.HouseID
+
+	err = a.loanScenarioRepository.Update(&scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scenario, nil
+}
+
+// DeleteLoanScenario removes a refinancing scenario
+func (a *App) DeleteLoanScenario(id int64) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditFinances); err != nil {
+		return err
+	}
+
+	return a.loanScenarioRepository.Delete(id)
+}
+
+// CompareLoanScenario calculates payments and the break-even point of a stored scenario
+func (a *App) CompareLoanScenario(id int64) (*models.LoanComparison, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	scenario, err := a.loanScenarioRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := scenario.Compare()
+	return &comparison, nil
+}
+
+// CalculateRefinancing compares loan terms without storing them, for quick what-if checks
+func (a *App) CalculateRefinancing(scenario models.LoanScenario) (*models.LoanComparison, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	if err := scenario.ValidateTerms(); err != nil {
+		return nil, err
+	}
+
+	comparison := scenario.Compare()
+	return &comparison, nil
+}
+
+// GetSettings returns the application settings
+func (a *App) GetSettings() (*models.Settings, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only administrators may see the mail server credentials
+	if a.authorize(models.PermissionAdminister) != nil {
+		settings.SMTPPassword = ""
+	}
+
+	return settings, nil
+}
+
+// UpdateSettings stores the application settings
+func (a *App) UpdateSettings(settings models.Settings) (*models.Settings, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	err := a.settingsRepository.Save(&settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// ExportConfiguration writes all settings (but no property data) to a JSON file
+func (a *App) ExportConfiguration(path string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return err
+	}
+	return configuration.Write(path, settings)
+}
+
+// ImportConfiguration replaces the settings with those of an exported configuration file
+func (a *App) ImportConfiguration(path string) (*models.Settings, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	file, err := configuration.Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := a.settingsRepository.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	// Secrets are not exported, so keep the ones already configured here
+	settings := file.Settings
+	settings.SMTPPassword = current.SMTPPassword
+
+	err = a.settingsRepository.Save(&settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// GetFeatureFlags returns every known feature with its effective state for
+// the logged-in user
+func (a *App) GetFeatureFlags() ([]models.FeatureFlag, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := a.featureFlagRepository.GetOverrides(a.currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ResolveFeatureFlags(overrides, settings.ExperimentalMode), nil
+}
+
+// SetFeatureEnabled switches a feature on or off for the logged-in user, or
+// for the whole installation in single-user mode
+func (a *App) SetFeatureEnabled(name string, enabled bool) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return err
+	}
+
+	return a.featureFlagRepository.Set(a.currentUserID, models.Feature(name), enabled)
+}
+
+// ResetFeature returns a feature to the installation's setting for the
+// logged-in user, or to its default in single-user mode
+func (a *App) ResetFeature(name string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return err
+	}
+
+	return a.featureFlagRepository.Reset(a.currentUserID, models.Feature(name))
+}
+
+// SetDefaultFeatureEnabled switches a feature on or off for every user who
+// has not chosen otherwise
+func (a *App) SetDefaultFeatureEnabled(name string, enabled bool) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	return a.featureFlagRepository.Set(0, models.Feature(name), enabled)
+}
+
+// ResetDefaultFeature returns a feature to its default for every user who
+// has not chosen otherwise
+func (a *App) ResetDefaultFeature(name string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	return a.featureFlagRepository.Reset(0, models.Feature(name))
+}
+
+// requireFeature returns an error unless the feature is enabled
+func (a *App) requireFeature(feature models.Feature) error {
+	definition, ok := models.LookupFeature(feature)
+	if !ok {
+		return fmt.Errorf("unknown feature %s", feature)
+	}
+
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return err
+	}
+
+	overrides, err := a.featureFlagRepository.GetOverrides(a.currentUserID)
+	if err != nil {
+		return err
+	}
+
+	if !definition.IsEnabled(overrides, settings.ExperimentalMode) {
+		return fmt.Errorf("%s is disabled in the feature settings", strings.ToLower(definition.Description))
+	}
+	return nil
+}
+
+// SendTestEmail sends a short message to verify the SMTP settings
+func (a *App) SendTestEmail(recipient string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return err
+	}
+
+	return a.sendEmail(mailer.Message{
+		To:      recipient,
+		Subject: "Property Management System test email",
+		Body:    "Your SMTP settings are working.",
+	}, nil)
+}
+
+// SendDocumentEmail sends the file at path as an attachment. When it concerns
+// a house whose property manager handles correspondence, the email goes out
+// in the manager's name; houseID 0 sends it in the owner's name.
+func (a *App) SendDocumentEmail(houseID int64, recipient, subject, body, path string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return err
+	}
+
+	if err := a.requireFeature(models.FeatureDocumentEmail); err != nil {
+		return err
+	}
+
+	var manager *models.PropertyManager
+	if houseID != 0 {
+		var err error
+		manager, err = a.propertyManagerRepository.GetByHouseID(houseID)
+		if err != nil && !errors.Is(err, repository.ErrPropertyManagerNotFound) {
+			return err
+		}
+	}
+
+	return a.sendEmail(mailer.Message{
+		To:          recipient,
+		Subject:     subject,
+		Body:        body,
+		Attachments: []string{path},
+	}, manager)
+}
+
+// GetEmailLog returns all sent and failed emails, most recent first
+func (a *App) GetEmailLog() ([]models.EmailLogEntry, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.emailLogRepository.GetAll()
+}
+
+// sendEmail delivers a message using the configured SMTP server and records
+// the outcome. If the house's property manager is given and currently handles
+// correspondence, the message is sent in its name with its contact details.
+func (a *App) sendEmail(message mailer.Message, manager *models.PropertyManager) error {
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return err
+	}
+
+	config := mailer.Config{
+		Host:        settings.SMTPHost,
+		Port:        settings.SMTPPort,
+		Username:    settings.SMTPUsername,
+		Password:    settings.SMTPPassword,
+		FromAddress: settings.SMTPFromAddress,
+		FromName:    settings.SMTPFromName,
+	}
+
+	// The owner's server still delivers the message, so only the visible
+	// sender, the reply address and the footer change
+	if manager != nil && manager.RoutesCorrespondence(time.Now()) {
+		config.FromName = manager.CompanyName
+		message.ReplyTo = strings.TrimSpace(manager.Email)
+		message.Body = strings.TrimRight(message.Body, "\n") + "\n\n-- \n" + manager.CorrespondenceFooter()
+	}
+
+	entry := &models.EmailLogEntry{
+		Recipient:   message.To,
+		Subject:     message.Subject,
+		Attachments: message.Attachments,
+		Status:      models.EmailStatusSent,
+	}
+
+	sendErr := mailer.Send(config, message)
+	if sendErr != nil {
+		entry.Status = models.EmailStatusFailed
+		entry.Error = sendErr.Error()
+	}
+
+	if err := a.emailLogRepository.Create(entry); err != nil {
+		return err
+	}
+
+	return sendErr
+}
+
+// SelectDocumentFile opens a native file picker and returns the chosen path (empty if cancelled)
+func (a *App) SelectDocumentFile() (string, error) {
+	return runtime.OpenFileDialog(a.ctx, runtime.OpenDialogOptions{
+		Title: "Select document",
+	})
+}
+
+// UploadDocument copies a file into the document vault and attaches it to a record
+func (a *App) UploadDocument(entityType string, entityID int64, sourcePath, description string) (*models.Document, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return nil, err
+	}
+
+	if !models.DocumentEntityType(entityType).IsValid() {
+		return nil, fmt.Errorf("documents cannot be attached to %q records", entityType)
+	}
+
+	storedPath, size, err := a.documentVault.Store(sourcePath, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	document := &models.Document{
+		EntityType:  models.DocumentEntityType(entityType),
+		EntityID:    entityID,
+		FileName:    filepath.Base(sourcePath),
+		StoredPath:  storedPath,
+		ContentType: mime.TypeByExtension(filepath.Ext(sourcePath)),
+		Size:        size,
+		Description: description,
+	}
+
+	err = a.documentRepository.Create(document)
+	if err != nil {
+		// Do not leave unreferenced files behind in the vault
+		a.documentVault.Remove(storedPath)
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// GetDocuments returns all documents attached to a record
+func (a *App) GetDocuments(entityType string, entityID int64) ([]models.Document, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.documentRepository.GetByEntity(models.DocumentEntityType(entityType), entityID)
+}
+
+// OpenDocument opens a stored document with the system's default application
+func (a *App) OpenDocument(id int64) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return err
+	}
+
+	document, err := a.documentRepository.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	path, err := a.documentVault.Path(document.StoredPath)
+	if err != nil {
+		return err
+	}
+
+	return vault.Open(path)
+}
+
+// DeleteDocument removes a document and its stored file
+func (a *App) DeleteDocument(id int64) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionEditProperties); err != nil {
+		return err
+	}
+
+	document, err := a.documentRepository.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	err = a.documentRepository.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	return a.documentVault.Remove(document.StoredPath)
+}
+
+// removeDocumentFiles deletes the vault files of documents whose records were
+// removed together with the record they were attached to
+func (a *App) removeDocumentFiles(documents []models.Document) {
+	for _, document := range documents {
+		if err := a.documentVault.Remove(document.StoredPath); err != nil {
+			log.Printf("Removing document file %s failed: %v", document.StoredPath, err)
+		}
+	}
+}
+
+// RunIntegrityCheck verifies the live database and test-restores the newest backup
+func (a *App) RunIntegrityCheck() (*models.IntegrityCheck, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	return a.runIntegrityCheck()
+}
+
+// runIntegrityCheck verifies the database and the newest backup and records the result
+func (a *App) runIntegrityCheck() (*models.IntegrityCheck, error) {
+	check := &models.IntegrityCheck{}
+	var problems []string
+
+	if err := db.CheckIntegrity(a.db); err != nil {
+		problems = append(problems, "database: "+err.Error())
+	} else {
+		check.DatabaseOK = true
+	}
+
+	// Only a backup that exists can be broken; without one there is nothing to verify
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return nil, err
+	}
+	var backupDir string
+	if settings.BackupSchedule == models.BackupScheduleOff {
+		check.BackupSkipped = true
+	} else if backupDir, err = a.backupDirectory(); err == nil {
+		check.BackupPath, err = db.NewestBackup(backupDir)
+		if errors.Is(err, db.ErrNoBackup) {
+			check.BackupSkipped = true
+			err = nil
+		} else if err == nil {
+			err = db.TestRestore(check.BackupPath)
+		}
+	}
+	if err != nil {
+		problems = append(problems, "backup: "+err.Error())
+	} else if !check.BackupSkipped {
+		check.BackupOK = true
+	}
+
+	check.Error = strings.Join(problems, "; ")
+	if err := a.integrityCheckRepository.Create(check); err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
+
+// GetIntegrityChecks returns the history of integrity checks, most recent first
+func (a *App) GetIntegrityChecks() ([]models.IntegrityCheck, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return a.integrityCheckRepository.GetAll()
+}
+
+// runScheduledIntegrityCheck runs the integrity check when the last one is older
+// than the check interval and notifies the frontend about failures
+func (a *App) runScheduledIntegrityCheck() {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	latest, err := a.integrityCheckRepository.GetLatest()
+	if err == nil && time.Since(latest.CheckedAt) < integrityCheckInterval {
+		return
+	}
+
+	check, err := a.runIntegrityCheck()
+	if err != nil {
+		log.Printf("Scheduled integrity check could not run: %v", err)
+		return
+	}
+
+	if !check.Passed() {
+		log.Printf("Scheduled integrity check failed: %s", check.Error)
+		runtime.EventsEmit(a.ctx, integrityCheckFailedEvent, check)
+	}
+}
+
+// RunBackupNow takes a backup into the backup directory right away, subject to the same rotation
+func (a *App) RunBackupNow() (*models.BackupRun, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	return a.runBackup(false)
+}
+
+// GetBackupRuns returns the history of backups taken into the backup directory, most recent first
+func (a *App) GetBackupRuns() ([]models.BackupRun, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	return a.backupRunRepository.GetAll()
+}
+
+// GetBackupStatus returns the backup schedule with the last backup and when the next one is due
+func (a *App) GetBackupStatus() (*models.BackupStatus, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if err := a.authorize(models.PermissionAdminister); err != nil {
+		return nil, err
+	}
+
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.BackupStatus{Schedule: settings.BackupSchedule}
+	if latest, err := a.backupRunRepository.GetLatestSuccessful(); err == nil {
+		status.LastBackup = latest
+	}
+	status.NextBackupAt = settings.BackupSchedule.NextBackupDue(status.LastBackup, time.Now())
+
+	return status, nil
+}
+
+// runBackup writes a rotated backup into the backup directory and records the outcome
+func (a *App) runBackup(scheduled bool) (*models.BackupRun, error) {
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	backupDir, err := a.backupDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	run := &models.BackupRun{StartedAt: time.Now(), Scheduled: scheduled}
+	run.Path, err = db.AutomaticBackup(a.db, backupDir, settings.BackupRetention)
+	if err == nil {
+		var info os.FileInfo
+		if info, err = os.Stat(run.Path); err == nil {
+			run.Size = info.Size()
+		}
+	}
+	if err != nil {
+		run.Error = err.Error()
+	}
+
+	if err := a.backupRunRepository.Create(run); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// runScheduledBackup takes an automatic backup when the schedule says one is due
+// and notifies the frontend about failures
+func (a *App) runScheduledBackup() {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		log.Printf("Scheduled backup could not read settings: %v", err)
+		return
+	}
+
+	var latest *models.BackupRun
+	if run, err := a.backupRunRepository.GetLatestSuccessful(); err == nil {
+		latest = run
+	}
+	due := settings.BackupSchedule.NextBackupDue(latest, time.Now())
+	if due == nil || time.Now().Before(*due) {
+		return
+	}
+
+	run, err := a.runBackup(true)
+	if err != nil {
+		log.Printf("Scheduled backup could not run: %v", err)
+		return
+	}
+
+	if !run.Succeeded() {
+		log.Printf("Scheduled backup failed: %s", run.Error)
+		runtime.EventsEmit(a.ctx, backupFailedEvent, run)
+	}
+}
+
+// backupDirectory returns the configured backup directory or the default one
+func (a *App) backupDirectory() (string, error) {
+	settings, err := a.settingsRepository.Get()
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(settings.BackupDirectory) != "" {
+		return settings.BackupDirectory, nil
+	}
+	return filepath.Join(db.DataDir(), "backups"), nil
+}
+
+// checkExternalChanges warns the frontend when another instance sharing the
+// database file has written to it, so stale data is reloaded before it is
+// edited and saved over the other instance's changes
+func (a *App) checkExternalChanges() {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.changeWatcher == nil {
+		return
+	}
+
+	changed, err := a.changeWatcher.Changed()
+	if err != nil {
+		log.Printf("Checking for changes by other instances failed: %v", err)
+		return
+	}
+
+	if changed {
+		log.Printf("Database %s was changed by another instance", db.Path())
+		runtime.EventsEmit(a.ctx, externalChangeEvent)
+	}
 }
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"property-management/internal/db"
+	"property-management/internal/dump"
+	"property-management/internal/models"
+	"property-management/internal/vault"
+)
+
+// newTestApp returns an App bound to a fresh live database in a temporary home
+// directory. The db package keeps a single live connection per process, so
+// only one test may use it.
+func newTestApp(t *testing.T) *App {
+	t.Setenv("HOME", t.TempDir())
+
+	app := NewApp()
+	app.ctx = context.Background()
+	app.mu.Lock()
+	app.setDB(db.GetDB())
+	app.mu.Unlock()
+	t.Cleanup(db.Close)
+
+	return app
+}
+
+func TestRestoreDatabaseWhileJobsRun(t *testing.T) {
+	app := newTestApp(t)
+
+	if _, err := app.CreateHouse("Backed Up House", "Street", "1", "Country", "12345", "City"); err != nil {
+		t.Fatalf("Error creating house: %v", err)
+	}
+	backupPath := filepath.Join(t.TempDir(), "backup.db")
+	if err := app.BackupDatabase(backupPath); err != nil {
+		t.Fatalf("Error backing up database: %v", err)
+	}
+
+	// Keep a backup job and frontend calls running while the database is swapped
+	stop := make(chan struct{})
+	errs := make(chan error, 2)
+	var wg sync.WaitGroup
+	loop := func(call func() error) {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if err := call(); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}
+
+	wg.Add(2)
+	go loop(func() error {
+		_, err := app.RunBackupNow()
+		return err
+	})
+	go loop(func() error {
+		_, err := app.GetAllHouses()
+		return err
+	})
+
+	for i := 0; i < 5; i++ {
+		if err := app.RestoreDatabase(backupPath); err != nil {
+			t.Errorf("Error restoring database: %v", err)
+		}
+	}
+
+	close(stop)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Error in call running during restore: %v", err)
+	}
+
+	houses, err := app.GetAllHouses()
+	if err != nil {
+		t.Fatalf("Error getting houses: %v", err)
+	}
+	if len(houses) != 1 || houses[0].Name != "Backed Up House" {
+		t.Errorf("Expected the restored house, got %+v", houses)
+	}
+}
+
+func TestDeleteRemovesDocumentFiles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	conn, err := db.OpenInMemory()
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	defer conn.Close()
+
+	app := NewApp()
+	app.ctx = context.Background()
+	app.setDB(conn)
+	vaultDir := t.TempDir()
+	app.documentVault = vault.New(vaultDir)
+
+	house, err := app.CreateHouse("House", "Street", "1", "Country", "12345", "City")
+	if err != nil {
+		t.Fatalf("Error creating house: %v", err)
+	}
+	meter, err := app.CreateMeter(house.ID, "water", "W-1", "Basement", "")
+	if err != nil {
+		t.Fatalf("Error creating meter: %v", err)
+	}
+
+	source := filepath.Join(t.TempDir(), "scan.pdf")
+	if err := os.WriteFile(source, []byte("%PDF"), 0644); err != nil {
+		t.Fatalf("Error writing source file: %v", err)
+	}
+	upload := func(entityType string, entityID int64) string {
+		t.Helper()
+		document, err := app.UploadDocument(entityType, entityID, source, "")
+		if err != nil {
+			t.Fatalf("Error uploading document: %v", err)
+		}
+		return filepath.Join(vaultDir, filepath.FromSlash(document.StoredPath))
+	}
+	houseFile := upload("house", house.ID)
+	meterFile := upload("meter", meter.ID)
+
+	if err := app.DeleteMeter(meter.ID); err != nil {
+		t.Fatalf("Error deleting meter: %v", err)
+	}
+	if _, err := os.Stat(meterFile); !os.IsNotExist(err) {
+		t.Error("Expected the meter's document file to be removed")
+	}
+	if _, err := os.Stat(houseFile); err != nil {
+		t.Errorf("Expected the house's document file to be kept, got %v", err)
+	}
+
+	if err := app.DeleteHouse(house.ID); err != nil {
+		t.Fatalf("Error deleting house: %v", err)
+	}
+	if _, err := os.Stat(houseFile); !os.IsNotExist(err) {
+		t.Error("Expected the house's document file to be removed")
+	}
+}
+
+func TestImportAllJSONFailureChangesNothing(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	conn, err := db.OpenInMemory()
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	defer conn.Close()
+
+	app := NewApp()
+	app.ctx = context.Background()
+	app.setDB(conn)
+
+	// Export two valid houses from another database
+	source, err := db.OpenInMemory()
+	if err != nil {
+		t.Fatalf("Error opening source database: %v", err)
+	}
+	defer source.Close()
+
+	sourceApp := NewApp()
+	sourceApp.setDB(source)
+	for _, name := range []string{"A House", "B House"} {
+		house, err := sourceApp.CreateHouse(name, name+" Street", "1", "Country", "12345", "City")
+		if err != nil {
+			t.Fatalf("Error creating house: %v", err)
+		}
+		scenario := models.LoanScenario{
+			Name:                   name + " offer",
+			OutstandingBalance:     100000,
+			CurrentRate:            3,
+			CurrentRemainingMonths: 120,
+			OfferRate:              2,
+			OfferTermMonths:        120,
+		}
+		if _, err := sourceApp.CreateLoanScenario(house.ID, scenario); err != nil {
+			t.Fatalf("Error creating loan scenario: %v", err)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "dump.json")
+	if err := dump.Write(path, source); err != nil {
+		t.Fatalf("Error writing dump: %v", err)
+	}
+
+	// Simulate a write failure in the live database while the second house is imported
+	_, err = conn.Exec(`
+		CREATE TRIGGER fail_second_house BEFORE INSERT ON loan_scenarios
+		WHEN NEW.name = 'B House offer'
+		BEGIN
+			SELECT RAISE(ABORT, 'disk full');
+		END`)
+	if err != nil {
+		t.Fatalf("Error creating trigger: %v", err)
+	}
+
+	if _, err := app.ImportAllJSON(path); err == nil {
+		t.Fatal("Expected error importing into a failing database, got nil")
+	}
+
+	houses, err := app.GetAllHouses()
+	if err != nil {
+		t.Fatalf("Error getting houses: %v", err)
+	}
+	if len(houses) != 0 {
+		t.Errorf("Expected no houses after the failed import, got %+v", houses)
+	}
+}
+
+func TestIntegrityCheckWithoutBackup(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	conn, err := db.OpenInMemory()
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	defer conn.Close()
+
+	app := NewApp()
+	app.ctx = context.Background()
+	app.setDB(conn)
+
+	settings, err := app.settingsRepository.Get()
+	if err != nil {
+		t.Fatalf("Error getting settings: %v", err)
+	}
+	settings.BackupDirectory = t.TempDir()
+	if err := app.settingsRepository.Save(settings); err != nil {
+		t.Fatalf("Error saving settings: %v", err)
+	}
+
+	// A fresh installation has no backup yet, which is not a failure
+	check, err := app.runIntegrityCheck()
+	if err != nil {
+		t.Fatalf("Error running integrity check: %v", err)
+	}
+	if !check.Passed() || !check.BackupSkipped || check.Error != "" {
+		t.Errorf("Expected passed check with skipped backup, got %+v", check)
+	}
+
+	// A backup that exists but cannot be restored fails the check
+	broken := filepath.Join(settings.BackupDirectory, "broken.db")
+	if err := os.WriteFile(broken, []byte("not a database"), 0644); err != nil {
+		t.Fatalf("Error writing broken backup: %v", err)
+	}
+	check, err = app.runIntegrityCheck()
+	if err != nil {
+		t.Fatalf("Error running integrity check: %v", err)
+	}
+	if check.Passed() || check.BackupSkipped || check.BackupPath != broken {
+		t.Errorf("Expected failed check for broken backup, got %+v", check)
+	}
+
+	// With automatic backups off there is nothing to verify
+	settings.BackupSchedule = models.BackupScheduleOff
+	if err := app.settingsRepository.Save(settings); err != nil {
+		t.Fatalf("Error saving settings: %v", err)
+	}
+	check, err = app.runIntegrityCheck()
+	if err != nil {
+		t.Fatalf("Error running integrity check: %v", err)
+	}
+	if !check.Passed() || !check.BackupSkipped {
+		t.Errorf("Expected passed check with skipped backup, got %+v", check)
+	}
+
+	// The outcome is recorded
+	latest, err := app.integrityCheckRepository.GetLatest()
+	if err != nil {
+		t.Fatalf("Error getting latest check: %v", err)
+	}
+	if !latest.BackupSkipped {
+		t.Errorf("Expected recorded check to have a skipped backup, got %+v", latest)
+	}
+}
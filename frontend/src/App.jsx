@@ -1,11 +1,17 @@
-import React, { useState, useEffect } from 'react';
+import React, { useState, useEffect, useRef } from 'react';
 import './styles/App.css';
 import Houses from './pages/Houses';
-import { GetAppInfo } from '../wailsjs/go/main/App';
+import Login from './pages/Login';
+import { GetAppInfo, IsLoginRequired, GetCurrentUser, Logout, GetIntegrityChecks, GetBackupRuns } from '../wailsjs/go/main/App';
+import { EventsOn, WindowReloadApp } from '../wailsjs/runtime/runtime';
 
 function App() {
   const [activePage, setActivePage] = useState('welcome');
   const [appInfo, setAppInfo] = useState({});
+  const [loginRequired, setLoginRequired] = useState(false);
+  const [currentUser, setCurrentUser] = useState(null);
+  const reportedIntegrityChecks = useRef(new Set());
+  const reportedBackupErrors = useRef(new Set());
 
   useEffect(() => {
     // Fetch application info from the backend
@@ -21,6 +27,96 @@ function App() {
     fetchAppInfo();
   }, []);
 
+  useEffect(() => {
+    // Installations with user accounts require a login at startup
+    const fetchSession = async () => {
+      try {
+        setLoginRequired(await IsLoginRequired());
+        setCurrentUser(await GetCurrentUser());
+      } catch (error) {
+        console.error('Error fetching session:', error);
+      }
+    };
+
+    fetchSession();
+  }, []);
+
+  const handleLogout = async () => {
+    await Logout();
+    setCurrentUser(null);
+    setActivePage('welcome');
+  };
+
+  useEffect(() => {
+    // Another instance sharing the database file changed the data; reload
+    // so nothing stale gets saved over it
+    return EventsOn('database-changed-externally', () => {
+      alert('The database was changed by another instance of the application. The data will be reloaded.');
+      WindowReloadApp();
+    });
+  }, []);
+
+  // Warn about a failed integrity check once, whether it arrives as an event
+  // or is found when the app starts
+  const reportIntegrityCheck = (check) => {
+    if (!check || (check.databaseOk && (check.backupOk || check.backupSkipped))) return;
+    if (reportedIntegrityChecks.current.has(check.id)) return;
+    reportedIntegrityChecks.current.add(check.id);
+    alert(`The integrity check failed: ${check.error}\n\nYour data or your newest backup may be damaged. Take a new backup and keep the old ones until a check passes again.`);
+  };
+
+  useEffect(() => {
+    // The background check runs weekly and reports failures as they happen
+    return EventsOn('integrity-check-failed', reportIntegrityCheck);
+  }, []);
+
+  useEffect(() => {
+    // A check that ran before this page was listening, or in an earlier
+    // session, would otherwise go unnoticed
+    if (loginRequired && !currentUser) return;
+
+    const fetchLatestIntegrityCheck = async () => {
+      try {
+        const checks = await GetIntegrityChecks();
+        reportIntegrityCheck(checks && checks[0]);
+      } catch (error) {
+        console.error('Error fetching integrity checks:', error);
+      }
+    };
+
+    fetchLatestIntegrityCheck();
+  }, [loginRequired, currentUser]);
+
+  // Scheduled backups are retried every hour while they fail; warn once per
+  // distinct error instead of on every attempt
+  const reportBackupRun = (run) => {
+    if (!run || !run.error) return;
+    if (reportedBackupErrors.current.has(run.error)) return;
+    reportedBackupErrors.current.add(run.error);
+    alert(`The automatic backup failed: ${run.error}\n\nCheck the backup directory in the settings. The backup is retried every hour.`);
+  };
+
+  useEffect(() => {
+    return EventsOn('backup-failed', reportBackupRun);
+  }, []);
+
+  useEffect(() => {
+    // Failures from before this page was listening are in the backup history,
+    // which only administrators may read
+    if (loginRequired && (!currentUser || currentUser.role !== 'admin')) return;
+
+    const fetchLatestBackupRun = async () => {
+      try {
+        const runs = await GetBackupRuns();
+        reportBackupRun(runs && runs[0]);
+      } catch (error) {
+        console.error('Error fetching backup runs:', error);
+      }
+    };
+
+    fetchLatestBackupRun();
+  }, [loginRequired, currentUser]);
+
   const renderContent = () => {
     switch (activePage) {
       case 'houses':
@@ -41,6 +137,14 @@ function App() {
     }
   };
 
+  if (loginRequired && !currentUser) {
+    return (
+      <div className="container">
+        <Login onLogin={setCurrentUser} />
+      </div>
+    );
+  }
+
   return (
     <div className="container">
       <header className="app-header">
@@ -64,6 +168,11 @@ function App() {
               >
                 Houses
               </li>
+              {currentUser && (
+                <li className="nav-item" onClick={handleLogout}>
+                  Log Out ({currentUser.username})
+                </li>
+              )}
             </ul>
           </nav>
         </div>
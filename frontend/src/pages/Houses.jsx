@@ -5,7 +5,7 @@ import ConfirmDialog from '../components/common/ConfirmDialog';
 import './Houses.css';
 
 // Import Go backend functions
-import { CreateHouse, GetAllHouses, UpdateHouse, DeleteHouse } from '../../wailsjs/go/main/App';
+import { CreateHouse, GetActiveHouses, UpdateHouse, DeleteHouse } from '../../wailsjs/go/main/App';
 
 const Houses = () => {
   const [houses, setHouses] = useState([]);
@@ -29,7 +29,7 @@ const Houses = () => {
       setIsLoading(true);
       setError(null);
       
-      const allHouses = await GetAllHouses();
+      const allHouses = await GetActiveHouses();
       setHouses(allHouses || []);
     } catch (err) {
       console.error('Error loading houses:', err);
@@ -0,0 +1,60 @@
+import React, { useState } from 'react';
+import './Login.css';
+import '../components/Houses/HouseForm.css';
+import { Login as LoginUser } from '../../wailsjs/go/main/App';
+
+const Login = ({ onLogin }) => {
+  const [username, setUsername] = useState('');
+  const [password, setPassword] = useState('');
+  const [error, setError] = useState(null);
+
+  const handleSubmit = async (e) => {
+    e.preventDefault();
+
+    try {
+      setError(null);
+      const user = await LoginUser(username, password);
+      onLogin(user);
+    } catch (err) {
+      console.error('Error logging in:', err);
+      setError(err.message || err);
+    }
+  };
+
+  return (
+    <form className="login-form" onSubmit={handleSubmit}>
+      <h2>Log In</h2>
+
+      {error && <div className="error-message">{error}</div>}
+
+      <div className="form-group">
+        <label htmlFor="username">Username</label>
+        <input
+          type="text"
+          id="username"
+          value={username}
+          onChange={(e) => setUsername(e.target.value)}
+          autoFocus
+        />
+      </div>
+
+      <div className="form-group">
+        <label htmlFor="password">Password</label>
+        <input
+          type="password"
+          id="password"
+          value={password}
+          onChange={(e) => setPassword(e.target.value)}
+        />
+      </div>
+
+      <div className="form-buttons">
+        <button type="submit" className="button primary">
+          Log In
+        </button>
+      </div>
+    </form>
+  );
+};
+
+export default Login;
@@ -1,15 +1,147 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {models} from '../models';
+import {guides} from '../models';
+import {db} from '../models';
+
+export function ArchiveHouse(arg1:number,arg2:string):Promise<models.House>;
+
+export function BackupDatabase(arg1:string):Promise<void>;
+
+export function CalculateRefinancing(arg1:models.LoanScenario):Promise<models.LoanComparison>;
+
+export function ChangePassword(arg1:string,arg2:string):Promise<void>;
+
+export function CompareLoanScenario(arg1:number):Promise<models.LoanComparison>;
 
 export function CreateHouse(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string):Promise<models.House>;
 
+export function CreateLoanScenario(arg1:number,arg2:models.LoanScenario):Promise<models.LoanScenario>;
+
+export function CreateMeter(arg1:number,arg2:string,arg3:string,arg4:string,arg5:string):Promise<models.Meter>;
+
+export function CreateMeterReading(arg1:number,arg2:string,arg3:number,arg4:string):Promise<models.MeterReading>;
+
+export function CreateUser(arg1:string,arg2:string,arg3:string):Promise<models.User>;
+
+export function DeleteDocument(arg1:number):Promise<void>;
+
 export function DeleteHouse(arg1:number):Promise<void>;
 
+export function DeleteLoanScenario(arg1:number):Promise<void>;
+
+export function DeleteMeter(arg1:number):Promise<void>;
+
+export function DeleteMeterReading(arg1:number):Promise<void>;
+
+export function DeletePropertyManager(arg1:number):Promise<void>;
+
+export function DeleteUser(arg1:number):Promise<void>;
+
+export function ExportAllJSON(arg1:string):Promise<void>;
+
+export function ExportConfiguration(arg1:string):Promise<void>;
+
+export function GetActiveHouses():Promise<Array<models.House>>;
+
 export function GetAllHouses():Promise<Array<models.House>>;
 
 export function GetAppInfo():Promise<Record<string, string>>;
 
+export function GetArchivedHouses():Promise<Array<models.House>>;
+
+export function GetBackupRuns():Promise<Array<models.BackupRun>>;
+
+export function GetBackupStatus():Promise<models.BackupStatus>;
+
+export function GetCurrentUser():Promise<models.User>;
+
+export function GetDocuments(arg1:string,arg2:number):Promise<Array<models.Document>>;
+
+export function GetEmailLog():Promise<Array<models.EmailLogEntry>>;
+
+export function GetFeatureFlags():Promise<Array<models.FeatureFlag>>;
+
+export function GetGuides():Promise<Array<guides.Guide>>;
+
 export function GetHouseByID(arg1:number):Promise<models.House>;
 
+export function GetHousesPage(arg1:models.PageRequest,arg2:boolean):Promise<models.HousePage>;
+
+export function GetIntegrityChecks():Promise<Array<models.IntegrityCheck>>;
+
+export function GetLoanScenarios(arg1:number):Promise<Array<models.LoanScenario>>;
+
+export function GetMeterConsumption(arg1:number,arg2:string,arg3:string):Promise<number>;
+
+export function GetMeterConsumptionDeltas(arg1:number):Promise<Array<models.ConsumptionDelta>>;
+
+export function GetMeterReadings(arg1:number):Promise<Array<models.MeterReading>>;
+
+export function GetMetersByHouseID(arg1:number):Promise<Array<models.Meter>>;
+
+export function GetPropertyManager(arg1:number):Promise<models.PropertyManager>;
+
+export function GetSettings():Promise<models.Settings>;
+
+export function GetSnapshots():Promise<Array<db.SnapshotInfo>>;
+
+export function GetUsers():Promise<Array<models.User>>;
+
+export function GetYieldMetrics(arg1:number,arg2:models.YieldInputs):Promise<models.YieldMetrics>;
+
+export function ImportAllJSON(arg1:string):Promise<models.MergeReport>;
+
+export function ImportConfiguration(arg1:string):Promise<models.Settings>;
+
+export function IsLoginRequired():Promise<boolean>;
+
+export function Login(arg1:string,arg2:string):Promise<models.User>;
+
+export function Logout():Promise<void>;
+
+export function MergeDatabase(arg1:string):Promise<models.MergeReport>;
+
+export function OpenDocument(arg1:number):Promise<void>;
+
+export function ResetDefaultFeature(arg1:string):Promise<void>;
+
+export function ResetFeature(arg1:string):Promise<void>;
+
+export function ResetUserPassword(arg1:number,arg2:string):Promise<void>;
+
+export function RestoreDatabase(arg1:string):Promise<void>;
+
+export function RollbackToSnapshot(arg1:string):Promise<void>;
+
+export function RunBackupNow():Promise<models.BackupRun>;
+
+export function RunIntegrityCheck():Promise<models.IntegrityCheck>;
+
+export function SavePropertyManager(arg1:number,arg2:models.PropertyManager):Promise<models.PropertyManager>;
+
+export function SelectDocumentFile():Promise<string>;
+
+export function SendDocumentEmail(arg1:number,arg2:string,arg3:string,arg4:string,arg5:string):Promise<void>;
+
+export function SendTestEmail(arg1:string):Promise<void>;
+
+export function SetDefaultFeatureEnabled(arg1:string,arg2:boolean):Promise<void>;
+
+export function SetFeatureEnabled(arg1:string,arg2:boolean):Promise<void>;
+
 export function UpdateHouse(arg1:number,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string,arg7:string):Promise<models.House>;
+
+export function UpdateHouseAcquisition(arg1:number,arg2:number,arg3:number,arg4:number):Promise<models.House>;
+
+export function UpdateLoanScenario(arg1:number,arg2:models.LoanScenario):Promise<models.LoanScenario>;
+
+export function UpdateMeter(arg1:number,arg2:string,arg3:string,arg4:string,arg5:string):Promise<models.Meter>;
+
+export function UpdateMeterReading(arg1:number,arg2:string,arg3:number,arg4:string):Promise<models.MeterReading>;
+
+export function UpdateSettings(arg1:models.Settings):Promise<models.Settings>;
+
+export function UpdateUserRole(arg1:number,arg2:string):Promise<models.User>;
+
+export function UploadDocument(arg1:string,arg2:number,arg3:string,arg4:string):Promise<models.Document>;
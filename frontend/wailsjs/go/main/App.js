@@ -2,14 +2,86 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function ArchiveHouse(arg1, arg2) {
+  return window['go']['main']['App']['ArchiveHouse'](arg1, arg2);
+}
+
+export function BackupDatabase(arg1) {
+  return window['go']['main']['App']['BackupDatabase'](arg1);
+}
+
+export function CalculateRefinancing(arg1) {
+  return window['go']['main']['App']['CalculateRefinancing'](arg1);
+}
+
+export function ChangePassword(arg1, arg2) {
+  return window['go']['main']['App']['ChangePassword'](arg1, arg2);
+}
+
+export function CompareLoanScenario(arg1) {
+  return window['go']['main']['App']['CompareLoanScenario'](arg1);
+}
+
 export function CreateHouse(arg1, arg2, arg3, arg4, arg5, arg6) {
   return window['go']['main']['App']['CreateHouse'](arg1, arg2, arg3, arg4, arg5, arg6);
 }
 
+export function CreateLoanScenario(arg1, arg2) {
+  return window['go']['main']['App']['CreateLoanScenario'](arg1, arg2);
+}
+
+export function CreateMeter(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['CreateMeter'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function CreateMeterReading(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['CreateMeterReading'](arg1, arg2, arg3, arg4);
+}
+
+export function CreateUser(arg1, arg2, arg3) {
+  return window['go']['main']['App']['CreateUser'](arg1, arg2, arg3);
+}
+
+export function DeleteDocument(arg1) {
+  return window['go']['main']['App']['DeleteDocument'](arg1);
+}
+
 export function DeleteHouse(arg1) {
   return window['go']['main']['App']['DeleteHouse'](arg1);
 }
 
+export function DeleteLoanScenario(arg1) {
+  return window['go']['main']['App']['DeleteLoanScenario'](arg1);
+}
+
+export function DeleteMeter(arg1) {
+  return window['go']['main']['App']['DeleteMeter'](arg1);
+}
+
+export function DeleteMeterReading(arg1) {
+  return window['go']['main']['App']['DeleteMeterReading'](arg1);
+}
+
+export function DeletePropertyManager(arg1) {
+  return window['go']['main']['App']['DeletePropertyManager'](arg1);
+}
+
+export function DeleteUser(arg1) {
+  return window['go']['main']['App']['DeleteUser'](arg1);
+}
+
+export function ExportAllJSON(arg1) {
+  return window['go']['main']['App']['ExportAllJSON'](arg1);
+}
+
+export function ExportConfiguration(arg1) {
+  return window['go']['main']['App']['ExportConfiguration'](arg1);
+}
+
+export function GetActiveHouses() {
+  return window['go']['main']['App']['GetActiveHouses']();
+}
+
 export function GetAllHouses() {
   return window['go']['main']['App']['GetAllHouses']();
 }
@@ -18,10 +90,198 @@ export function GetAppInfo() {
   return window['go']['main']['App']['GetAppInfo']();
 }
 
+export function GetArchivedHouses() {
+  return window['go']['main']['App']['GetArchivedHouses']();
+}
+
+export function GetBackupRuns() {
+  return window['go']['main']['App']['GetBackupRuns']();
+}
+
+export function GetBackupStatus() {
+  return window['go']['main']['App']['GetBackupStatus']();
+}
+
+export function GetCurrentUser() {
+  return window['go']['main']['App']['GetCurrentUser']();
+}
+
+export function GetDocuments(arg1, arg2) {
+  return window['go']['main']['App']['GetDocuments'](arg1, arg2);
+}
+
+export function GetEmailLog() {
+  return window['go']['main']['App']['GetEmailLog']();
+}
+
+export function GetFeatureFlags() {
+  return window['go']['main']['App']['GetFeatureFlags']();
+}
+
+export function GetGuides() {
+  return window['go']['main']['App']['GetGuides']();
+}
+
 export function GetHouseByID(arg1) {
   return window['go']['main']['App']['GetHouseByID'](arg1);
 }
 
+export function GetHousesPage(arg1, arg2) {
+  return window['go']['main']['App']['GetHousesPage'](arg1, arg2);
+}
+
+export function GetIntegrityChecks() {
+  return window['go']['main']['App']['GetIntegrityChecks']();
+}
+
+export function GetLoanScenarios(arg1) {
+  return window['go']['main']['App']['GetLoanScenarios'](arg1);
+}
+
+export function GetMeterConsumption(arg1, arg2, arg3) {
+  return window['go']['main']['App']['GetMeterConsumption'](arg1, arg2, arg3);
+}
+
+export function GetMeterConsumptionDeltas(arg1) {
+  return window['go']['main']['App']['GetMeterConsumptionDeltas'](arg1);
+}
+
+export function GetMeterReadings(arg1) {
+  return window['go']['main']['App']['GetMeterReadings'](arg1);
+}
+
+export function GetMetersByHouseID(arg1) {
+  return window['go']['main']['App']['GetMetersByHouseID'](arg1);
+}
+
+export function GetPropertyManager(arg1) {
+  return window['go']['main']['App']['GetPropertyManager'](arg1);
+}
+
+export function GetSettings() {
+  return window['go']['main']['App']['GetSettings']();
+}
+
+export function GetSnapshots() {
+  return window['go']['main']['App']['GetSnapshots']();
+}
+
+export function GetUsers() {
+  return window['go']['main']['App']['GetUsers']();
+}
+
+export function GetYieldMetrics(arg1, arg2) {
+  return window['go']['main']['App']['GetYieldMetrics'](arg1, arg2);
+}
+
+export function ImportAllJSON(arg1) {
+  return window['go']['main']['App']['ImportAllJSON'](arg1);
+}
+
+export function ImportConfiguration(arg1) {
+  return window['go']['main']['App']['ImportConfiguration'](arg1);
+}
+
+export function IsLoginRequired() {
+  return window['go']['main']['App']['IsLoginRequired']();
+}
+
+export function Login(arg1, arg2) {
+  return window['go']['main']['App']['Login'](arg1, arg2);
+}
+
+export function Logout() {
+  return window['go']['main']['App']['Logout']();
+}
+
+export function MergeDatabase(arg1) {
+  return window['go']['main']['App']['MergeDatabase'](arg1);
+}
+
+export function OpenDocument(arg1) {
+  return window['go']['main']['App']['OpenDocument'](arg1);
+}
+
+export function ResetDefaultFeature(arg1) {
+  return window['go']['main']['App']['ResetDefaultFeature'](arg1);
+}
+
+export function ResetFeature(arg1) {
+  return window['go']['main']['App']['ResetFeature'](arg1);
+}
+
+export function ResetUserPassword(arg1, arg2) {
+  return window['go']['main']['App']['ResetUserPassword'](arg1, arg2);
+}
+
+export function RestoreDatabase(arg1) {
+  return window['go']['main']['App']['RestoreDatabase'](arg1);
+}
+
+export function RollbackToSnapshot(arg1) {
+  return window['go']['main']['App']['RollbackToSnapshot'](arg1);
+}
+
+export function RunBackupNow() {
+  return window['go']['main']['App']['RunBackupNow']();
+}
+
+export function RunIntegrityCheck() {
+  return window['go']['main']['App']['RunIntegrityCheck']();
+}
+
+export function SavePropertyManager(arg1, arg2) {
+  return window['go']['main']['App']['SavePropertyManager'](arg1, arg2);
+}
+
+export function SelectDocumentFile() {
+  return window['go']['main']['App']['SelectDocumentFile']();
+}
+
+export function SendDocumentEmail(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['SendDocumentEmail'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function SendTestEmail(arg1) {
+  return window['go']['main']['App']['SendTestEmail'](arg1);
+}
+
+export function SetDefaultFeatureEnabled(arg1, arg2) {
+  return window['go']['main']['App']['SetDefaultFeatureEnabled'](arg1, arg2);
+}
+
+export function SetFeatureEnabled(arg1, arg2) {
+  return window['go']['main']['App']['SetFeatureEnabled'](arg1, arg2);
+}
+
 export function UpdateHouse(arg1, arg2, arg3, arg4, arg5, arg6, arg7) {
   return window['go']['main']['App']['UpdateHouse'](arg1, arg2, arg3, arg4, arg5, arg6, arg7);
 }
+
+export function UpdateHouseAcquisition(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['UpdateHouseAcquisition'](arg1, arg2, arg3, arg4);
+}
+
+export function UpdateLoanScenario(arg1, arg2) {
+  return window['go']['main']['App']['UpdateLoanScenario'](arg1, arg2);
+}
+
+export function UpdateMeter(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['UpdateMeter'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function UpdateMeterReading(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['UpdateMeterReading'](arg1, arg2, arg3, arg4);
+}
+
+export function UpdateSettings(arg1) {
+  return window['go']['main']['App']['UpdateSettings'](arg1);
+}
+
+export function UpdateUserRole(arg1, arg2) {
+  return window['go']['main']['App']['UpdateUserRole'](arg1, arg2);
+}
+
+export function UploadDocument(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['UploadDocument'](arg1, arg2, arg3, arg4);
+}
@@ -1,5 +1,324 @@
+export namespace db {
+	
+	export class SnapshotInfo {
+	    path: string;
+	    reason: string;
+	    // Go type: time
+	    createdAt: any;
+	    size: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SnapshotInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.reason = source["reason"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.size = source["size"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace guides {
+	
+	export class Step {
+	    title: string;
+	    body: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Step(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.title = source["title"];
+	        this.body = source["body"];
+	    }
+	}
+	export class Guide {
+	    id: string;
+	    title: string;
+	    summary: string;
+	    steps: Step[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Guide(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.summary = source["summary"];
+	        this.steps = this.convertValues(source["steps"], Step);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
 export namespace models {
 	
+	export class BackupRun {
+	    id: number;
+	    // Go type: time
+	    startedAt: any;
+	    scheduled: boolean;
+	    path: string;
+	    size: number;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new BackupRun(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.startedAt = this.convertValues(source["startedAt"], null);
+	        this.scheduled = source["scheduled"];
+	        this.path = source["path"];
+	        this.size = source["size"];
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BackupStatus {
+	    schedule: string;
+	    lastBackup?: BackupRun;
+	    // Go type: time
+	    nextBackupAt?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new BackupStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.schedule = source["schedule"];
+	        this.lastBackup = this.convertValues(source["lastBackup"], BackupRun);
+	        this.nextBackupAt = this.convertValues(source["nextBackupAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ConsumptionDelta {
+	    // Go type: time
+	    from: any;
+	    // Go type: time
+	    to: any;
+	    consumption: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConsumptionDelta(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.from = this.convertValues(source["from"], null);
+	        this.to = this.convertValues(source["to"], null);
+	        this.consumption = source["consumption"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Document {
+	    id: number;
+	    entityType: string;
+	    entityId: number;
+	    fileName: string;
+	    storedPath: string;
+	    contentType: string;
+	    size: number;
+	    description: string;
+	    // Go type: time
+	    createdAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Document(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.entityType = source["entityType"];
+	        this.entityId = source["entityId"];
+	        this.fileName = source["fileName"];
+	        this.storedPath = source["storedPath"];
+	        this.contentType = source["contentType"];
+	        this.size = source["size"];
+	        this.description = source["description"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EmailLogEntry {
+	    id: number;
+	    recipient: string;
+	    subject: string;
+	    attachments: string[];
+	    status: string;
+	    error: string;
+	    // Go type: time
+	    sentAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new EmailLogEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.recipient = source["recipient"];
+	        this.subject = source["subject"];
+	        this.attachments = source["attachments"];
+	        this.status = source["status"];
+	        this.error = source["error"];
+	        this.sentAt = this.convertValues(source["sentAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FeatureFlag {
+	    name: string;
+	    description: string;
+	    experimental: boolean;
+	    enabledByDefault: boolean;
+	    enabled: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new FeatureFlag(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.experimental = source["experimental"];
+	        this.enabledByDefault = source["enabledByDefault"];
+	        this.enabled = source["enabled"];
+	    }
+	}
 	export class House {
 	    id: number;
 	    name: string;
@@ -9,6 +328,11 @@ export namespace models {
 	    zipCode: string;
 	    city: string;
 	    // Go type: time
+	    saleDate?: any;
+	    purchasePrice: number;
+	    incidentalCosts: number;
+	    landValueShare: number;
+	    // Go type: time
 	    createdAt: any;
 	    // Go type: time
 	    updatedAt: any;
@@ -26,6 +350,10 @@ export namespace models {
 	        this.country = source["country"];
 	        this.zipCode = source["zipCode"];
 	        this.city = source["city"];
+	        this.saleDate = this.convertValues(source["saleDate"], null);
+	        this.purchasePrice = source["purchasePrice"];
+	        this.incidentalCosts = source["incidentalCosts"];
+	        this.landValueShare = source["landValueShare"];
 	        this.createdAt = this.convertValues(source["createdAt"], null);
 	        this.updatedAt = this.convertValues(source["updatedAt"], null);
 	    }
@@ -48,6 +376,464 @@ export namespace models {
 		    return a;
 		}
 	}
+	export class HousePage {
+	    houses: House[];
+	    total: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new HousePage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.houses = this.convertValues(source["houses"], House);
+	        this.total = source["total"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class IntegrityCheck {
+	    id: number;
+	    // Go type: time
+	    checkedAt: any;
+	    databaseOk: boolean;
+	    backupPath: string;
+	    backupOk: boolean;
+	    backupSkipped: boolean;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IntegrityCheck(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.checkedAt = this.convertValues(source["checkedAt"], null);
+	        this.databaseOk = source["databaseOk"];
+	        this.backupPath = source["backupPath"];
+	        this.backupOk = source["backupOk"];
+	        this.backupSkipped = source["backupSkipped"];
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LoanComparison {
+	    currentMonthlyPayment: number;
+	    currentTotalInterest: number;
+	    offerMonthlyPayment: number;
+	    offerTotalInterest: number;
+	    monthlySavings: number;
+	    totalSavings: number;
+	    breakEvenMonths: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoanComparison(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.currentMonthlyPayment = source["currentMonthlyPayment"];
+	        this.currentTotalInterest = source["currentTotalInterest"];
+	        this.offerMonthlyPayment = source["offerMonthlyPayment"];
+	        this.offerTotalInterest = source["offerTotalInterest"];
+	        this.monthlySavings = source["monthlySavings"];
+	        this.totalSavings = source["totalSavings"];
+	        this.breakEvenMonths = source["breakEvenMonths"];
+	    }
+	}
+	export class LoanScenario {
+	    id: number;
+	    houseId: number;
+	    name: string;
+	    outstandingBalance: number;
+	    currentRate: number;
+	    currentRemainingMonths: number;
+	    offerRate: number;
+	    offerTermMonths: number;
+	    offerFees: number;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoanScenario(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.houseId = source["houseId"];
+	        this.name = source["name"];
+	        this.outstandingBalance = source["outstandingBalance"];
+	        this.currentRate = source["currentRate"];
+	        this.currentRemainingMonths = source["currentRemainingMonths"];
+	        this.offerRate = source["offerRate"];
+	        this.offerTermMonths = source["offerTermMonths"];
+	        this.offerFees = source["offerFees"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MergeReport {
+	    housesAdded: number;
+	    housesMatched: number;
+	    propertyManagersAdded: number;
+	    metersAdded: number;
+	    readingsAdded: number;
+	    loanScenariosAdded: number;
+	    conflicts: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MergeReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.housesAdded = source["housesAdded"];
+	        this.housesMatched = source["housesMatched"];
+	        this.propertyManagersAdded = source["propertyManagersAdded"];
+	        this.metersAdded = source["metersAdded"];
+	        this.readingsAdded = source["readingsAdded"];
+	        this.loanScenariosAdded = source["loanScenariosAdded"];
+	        this.conflicts = source["conflicts"];
+	    }
+	}
+	export class Meter {
+	    id: number;
+	    houseId: number;
+	    type: string;
+	    serialNumber: string;
+	    location: string;
+	    unit: string;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Meter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.houseId = source["houseId"];
+	        this.type = source["type"];
+	        this.serialNumber = source["serialNumber"];
+	        this.location = source["location"];
+	        this.unit = source["unit"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MeterReading {
+	    id: number;
+	    meterId: number;
+	    // Go type: time
+	    readingDate: any;
+	    value: number;
+	    note: string;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new MeterReading(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.meterId = source["meterId"];
+	        this.readingDate = this.convertValues(source["readingDate"], null);
+	        this.value = source["value"];
+	        this.note = source["note"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PageRequest {
+	    limit: number;
+	    offset: number;
+	    sortBy: string;
+	    descending: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PageRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.limit = source["limit"];
+	        this.offset = source["offset"];
+	        this.sortBy = source["sortBy"];
+	        this.descending = source["descending"];
+	    }
+	}
+	export class PropertyManager {
+	    id: number;
+	    houseId: number;
+	    companyName: string;
+	    contactName: string;
+	    street: string;
+	    number: string;
+	    country: string;
+	    zipCode: string;
+	    city: string;
+	    phone: string;
+	    email: string;
+	    // Go type: time
+	    mandateStart?: any;
+	    // Go type: time
+	    mandateEnd?: any;
+	    useForCorrespondence: boolean;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new PropertyManager(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.houseId = source["houseId"];
+	        this.companyName = source["companyName"];
+	        this.contactName = source["contactName"];
+	        this.street = source["street"];
+	        this.number = source["number"];
+	        this.country = source["country"];
+	        this.zipCode = source["zipCode"];
+	        this.city = source["city"];
+	        this.phone = source["phone"];
+	        this.email = source["email"];
+	        this.mandateStart = this.convertValues(source["mandateStart"], null);
+	        this.mandateEnd = this.convertValues(source["mandateEnd"], null);
+	        this.useForCorrespondence = source["useForCorrespondence"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Settings {
+	    marginalTaxRate: number;
+	    showPostTaxFigures: boolean;
+	    smtpHost: string;
+	    smtpPort: number;
+	    smtpUsername: string;
+	    smtpPassword: string;
+	    smtpFromAddress: string;
+	    smtpFromName: string;
+	    backupDirectory: string;
+	    backupSchedule: string;
+	    backupRetention: number;
+	    experimentalMode: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Settings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.marginalTaxRate = source["marginalTaxRate"];
+	        this.showPostTaxFigures = source["showPostTaxFigures"];
+	        this.smtpHost = source["smtpHost"];
+	        this.smtpPort = source["smtpPort"];
+	        this.smtpUsername = source["smtpUsername"];
+	        this.smtpPassword = source["smtpPassword"];
+	        this.smtpFromAddress = source["smtpFromAddress"];
+	        this.smtpFromName = source["smtpFromName"];
+	        this.backupDirectory = source["backupDirectory"];
+	        this.backupSchedule = source["backupSchedule"];
+	        this.backupRetention = source["backupRetention"];
+	        this.experimentalMode = source["experimentalMode"];
+	    }
+	}
+	export class User {
+	    id: number;
+	    username: string;
+	    role: string;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new User(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.username = source["username"];
+	        this.role = source["role"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class YieldInputs {
+	    annualRent: number;
+	    annualOperatingCosts: number;
+	    annualDebtService: number;
+	    equity: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new YieldInputs(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.annualRent = source["annualRent"];
+	        this.annualOperatingCosts = source["annualOperatingCosts"];
+	        this.annualDebtService = source["annualDebtService"];
+	        this.equity = source["equity"];
+	    }
+	}
+	export class YieldMetrics {
+	    totalInvestment: number;
+	    grossInitialYield: number;
+	    netInitialYield: number;
+	    cashOnCashReturn: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new YieldMetrics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.totalInvestment = source["totalInvestment"];
+	        this.grossInitialYield = source["grossInitialYield"];
+	        this.netInitialYield = source["netInitialYield"];
+	        this.cashOnCashReturn = source["cashOnCashReturn"];
+	    }
+	}
 
 }
 
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHashAndCheckPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse")
+	if err != nil {
+		t.Fatalf("Error hashing password: %v", err)
+	}
+
+	// Hashes record their scheme and parameters
+	if !strings.HasPrefix(hash, "pbkdf2-sha256$210000$") {
+		t.Errorf("Expected hash in pbkdf2-sha256 format, got %q", hash)
+	}
+
+	// Hashes are salted
+	other, err := HashPassword("correct horse")
+	if err != nil {
+		t.Fatalf("Error hashing password: %v", err)
+	}
+	if hash == other {
+		t.Error("Expected different hashes for the same password")
+	}
+
+	ok, err := CheckPassword(hash, "correct horse")
+	if err != nil || !ok {
+		t.Errorf("Expected password to match, got %v (%v)", ok, err)
+	}
+
+	ok, err = CheckPassword(hash, "wrong horse")
+	if err != nil || ok {
+		t.Errorf("Expected wrong password not to match, got %v (%v)", ok, err)
+	}
+
+	// Test short password
+	if _, err := HashPassword("short"); err != ErrPasswordTooShort {
+		t.Errorf("Expected ErrPasswordTooShort, got %v", err)
+	}
+
+	// Test malformed hash
+	if _, err := CheckPassword("plain-text", "correct horse"); err == nil {
+		t.Error("Expected error for malformed hash, got nil")
+	}
+}
+
+func TestCheckPassword_StoredHash(t *testing.T) {
+	// Hashes already stored in the database keep working, whatever their iteration count
+	stored := "pbkdf2-sha256$1000$cHJvcGVydHktc2FsdC0wMQ$oAAbRe4TB+hpXI3g0z1MfxsuZWMr7Oj2fCuTx3qK4IE"
+
+	ok, err := CheckPassword(stored, "correct horse")
+	if err != nil || !ok {
+		t.Errorf("Expected stored hash to match, got %v (%v)", ok, err)
+	}
+
+	ok, err = CheckPassword(stored, "wrong horse")
+	if err != nil || ok {
+		t.Errorf("Expected wrong password not to match stored hash, got %v (%v)", ok, err)
+	}
+}
@@ -0,0 +1,72 @@
+package configuration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// Format identifies configuration files written by this application
+const Format = "property-management-configuration"
+
+// Version is the configuration file layout written by this version of the application
+const Version = 1
+
+// File is the on-disk representation of an exported configuration. It
+// deliberately contains no tenant or property data.
+type File struct {
+	Format     string          `json:"format"`
+	Version    int             `json:"version"`
+	ExportedAt time.Time       `json:"exportedAt"`
+	Settings   models.Settings `json:"settings"`
+}
+
+// Write exports the settings to a JSON file at path. Secrets such as the
+// SMTP password are left out and have to be entered again after importing.
+func Write(path string, settings *models.Settings) error {
+	file := File{
+		Format:     Format,
+		Version:    Version,
+		ExportedAt: time.Now(),
+		Settings:   *settings,
+	}
+	file.Settings.SMTPPassword = ""
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+// Read loads and validates a configuration file
+func Read(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Settings missing from older exports keep their defaults
+	file := File{Settings: *models.DefaultSettings()}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid configuration file: %w", err)
+	}
+
+	if file.Format != Format {
+		return nil, errors.New("file is not a property management configuration export")
+	}
+	if file.Version < 1 || file.Version > Version {
+		return nil, fmt.Errorf("unsupported configuration version %d", file.Version)
+	}
+
+	if err := file.Settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &file, nil
+}
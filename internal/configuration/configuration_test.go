@@ -0,0 +1,66 @@
+package configuration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"property-management/internal/models"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	settings := models.DefaultSettings()
+	settings.MarginalTaxRate = 42
+	settings.SMTPHost = "smtp.example.com"
+	settings.SMTPFromAddress = "vermieter@example.com"
+	settings.SMTPPassword = "secret"
+
+	if err := Write(path, settings); err != nil {
+		t.Fatalf("Error writing configuration: %v", err)
+	}
+
+	// Secrets never end up in the file
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading configuration file: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Error("Expected SMTP password to be left out of the export")
+	}
+
+	file, err := Read(path)
+	if err != nil {
+		t.Fatalf("Error reading configuration: %v", err)
+	}
+	if file.Settings.MarginalTaxRate != 42 || file.Settings.SMTPHost != "smtp.example.com" {
+		t.Errorf("Settings were not properly round-tripped: %+v", file.Settings)
+	}
+	if file.Settings.SMTPPassword != "" {
+		t.Error("Expected SMTP password to be empty after import")
+	}
+}
+
+func TestRead_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	cases := map[string]string{
+		"garbage.json":   "not json",
+		"foreign.json":   `{"format":"something-else","version":1}`,
+		"future.json":    `{"format":"property-management-configuration","version":99}`,
+		"invalid.json":   `{"format":"property-management-configuration","version":1,"settings":{"marginalTaxRate":150}}`,
+		"truncated.json": `{"format":"property-management-configuration"`,
+	}
+
+	for name, content := range cases {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Error writing %s: %v", name, err)
+		}
+		if _, err := Read(path); err == nil {
+			t.Errorf("Expected error reading %s, got nil", name)
+		}
+	}
+}
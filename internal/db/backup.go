@@ -0,0 +1,185 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Backup writes a consistent snapshot of the live database to targetPath.
+// The snapshot is written next to the target first and only moved into place
+// once its integrity has been verified, so a failed backup never replaces a good one.
+func Backup(db *sql.DB, targetPath string) error {
+	if strings.TrimSpace(targetPath) == "" {
+		return errors.New("backup path cannot be empty")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0755); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	// VACUUM INTO refuses to overwrite existing files
+	tmpPath := targetPath + ".tmp"
+	os.Remove(tmpPath)
+	defer os.Remove(tmpPath)
+
+	if _, err := db.Exec(`VACUUM INTO ?`, tmpPath); err != nil {
+		return fmt.Errorf("failed to snapshot database: %w", err)
+	}
+
+	if err := VerifyBackup(tmpPath); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, targetPath)
+}
+
+// readOnlyDSN returns a data source name that opens the database at path
+// read-only. SQLite only honours mode=ro in URI filenames, which need the
+// characters that delimit the query and fragment escaped.
+func readOnlyDSN(path string) string {
+	escaped := strings.NewReplacer("%", "%25", "?", "%3f", "#", "%23").Replace(filepath.ToSlash(path))
+	return "file:" + escaped + "?mode=ro"
+}
+
+// VerifyBackup checks that the file at path is an intact database of this
+// application that the running version is able to open
+func VerifyBackup(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("backup file not accessible: %w", err)
+	}
+
+	conn, err := sql.Open("sqlite3", readOnlyDSN(path))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var result string
+	if err := conn.QueryRow(`PRAGMA integrity_check`).Scan(&result); err != nil {
+		return fmt.Errorf("backup is not a valid database: %w", err)
+	}
+	if result != "ok" {
+		return fmt.Errorf("backup failed integrity check: %s", result)
+	}
+
+	var version sql.NullInt64
+	if err := conn.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version); err != nil {
+		return errors.New("backup is not a property management database")
+	}
+
+	latest, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+	if int(version.Int64) > latest {
+		return fmt.Errorf("backup was created by a newer version of the application (schema %d, supported %d)", version.Int64, latest)
+	}
+
+	return nil
+}
+
+// Restore replaces the live database with the backup at sourcePath and
+// reopens the connection. Callers must discard any repositories bound to
+// the previous connection and use the returned one instead. If the restore
+// fails, the previous database is kept and its connection is returned
+// together with the error; the connection is only nil if no database could
+// be reopened at all.
+func Restore(sourcePath string) (*sql.DB, error) {
+	if err := VerifyBackup(sourcePath); err != nil {
+		return dbInstance, err
+	}
+
+	// Stage the copy next to the live file so the final swap is a rename
+	dbPath := Path()
+	stagedPath := dbPath + ".restore"
+	if err := copyFile(sourcePath, stagedPath); err != nil {
+		return dbInstance, fmt.Errorf("failed to stage backup: %w", err)
+	}
+	defer os.Remove(stagedPath)
+
+	Close()
+
+	// Keep the current file until the restored one has been opened
+	previousPath := dbPath + ".bak"
+	if err := os.Rename(dbPath, previousPath); err != nil {
+		return reopen(dbPath, fmt.Errorf("failed to set aside current database: %w", err))
+	}
+
+	if err := replaceDatabaseFile(stagedPath, dbPath); err != nil {
+		return putBack(previousPath, dbPath, err)
+	}
+
+	// Reopening also migrates backups taken by older versions
+	conn, err := openDatabase(dbPath)
+	if err != nil {
+		return putBack(previousPath, dbPath, fmt.Errorf("failed to open restored database: %w", err))
+	}
+
+	os.Remove(previousPath)
+	dbInstance = conn
+	return conn, nil
+}
+
+// putBack moves the database set aside by Restore back into place and
+// reconnects to it, returning the error that made the restore fail
+func putBack(previousPath, dbPath string, restoreErr error) (*sql.DB, error) {
+	if err := replaceDatabaseFile(previousPath, dbPath); err != nil {
+		dbInstance = nil
+		return nil, fmt.Errorf("%w; putting back the previous database also failed, it is kept at %s: %v", restoreErr, previousPath, err)
+	}
+	return reopen(dbPath, restoreErr)
+}
+
+// reopen reconnects to the database after a failed restore and returns the
+// connection together with the error that made the restore fail
+func reopen(dbPath string, restoreErr error) (*sql.DB, error) {
+	conn, err := openDatabase(dbPath)
+	if err != nil {
+		dbInstance = nil
+		return nil, fmt.Errorf("%w; reopening the previous database also failed: %v", restoreErr, err)
+	}
+
+	dbInstance = conn
+	return conn, restoreErr
+}
+
+// replaceDatabaseFile moves sourcePath over dbPath, dropping stale journal files
+func replaceDatabaseFile(sourcePath, dbPath string) error {
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		if err := os.Remove(dbPath + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(sourcePath, dbPath)
+}
+
+// copyFile copies the file at src to dst, replacing dst if it exists
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,247 @@
+package db
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBackup(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Error migrating database: %v", err)
+	}
+
+	_, err := db.Exec(`INSERT INTO houses (name, street, number, country, zip_code, city)
+		VALUES ('Backup House', 'Street', '1', 'Country', '12345', 'City')`)
+	if err != nil {
+		t.Fatalf("Error inserting test house: %v", err)
+	}
+
+	// Back up into a directory that does not exist yet
+	targetPath := filepath.Join(t.TempDir(), "backups", "snapshot.db")
+	if err := Backup(db, targetPath); err != nil {
+		t.Fatalf("Error backing up database: %v", err)
+	}
+
+	if err := VerifyBackup(targetPath); err != nil {
+		t.Errorf("Expected backup to verify, got %v", err)
+	}
+
+	// Backing up again overwrites the previous snapshot
+	if err := Backup(db, targetPath); err != nil {
+		t.Errorf("Error overwriting backup: %v", err)
+	}
+
+	if _, err := os.Stat(targetPath + ".tmp"); !os.IsNotExist(err) {
+		t.Error("Expected temporary snapshot file to be removed")
+	}
+
+	// Restore the snapshot over another database file and check the data
+	restoredPath := filepath.Join(t.TempDir(), "restored.db")
+	if err := copyFile(targetPath, restoredPath+".restore"); err != nil {
+		t.Fatalf("Error staging backup: %v", err)
+	}
+	if err := replaceDatabaseFile(restoredPath+".restore", restoredPath); err != nil {
+		t.Fatalf("Error replacing database file: %v", err)
+	}
+
+	restored, err := openDatabase(restoredPath)
+	if err != nil {
+		t.Fatalf("Error opening restored database: %v", err)
+	}
+	defer restored.Close()
+
+	var name string
+	if err := restored.QueryRow(`SELECT name FROM houses`).Scan(&name); err != nil {
+		t.Fatalf("Error reading restored house: %v", err)
+	}
+	if name != "Backup House" {
+		t.Errorf("Expected restored house 'Backup House', got %q", name)
+	}
+}
+
+func TestVerifyBackup_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	// Missing file
+	if err := VerifyBackup(filepath.Join(dir, "missing.db")); err == nil {
+		t.Error("Expected error for missing backup, got nil")
+	}
+
+	// Not a database at all
+	garbagePath := filepath.Join(dir, "garbage.db")
+	if err := os.WriteFile(garbagePath, []byte("definitely not sqlite"), 0644); err != nil {
+		t.Fatalf("Error writing garbage file: %v", err)
+	}
+	if err := VerifyBackup(garbagePath); err == nil {
+		t.Error("Expected error for invalid backup, got nil")
+	}
+
+	// A database from a newer application version
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Error migrating database: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO schema_migrations (version, name) VALUES (9999, 'future')`); err != nil {
+		t.Fatalf("Error recording future migration: %v", err)
+	}
+
+	futurePath := filepath.Join(dir, "future.db")
+	if _, err := db.Exec(`VACUUM INTO ?`, futurePath); err != nil {
+		t.Fatalf("Error snapshotting database: %v", err)
+	}
+	if err := VerifyBackup(futurePath); err == nil {
+		t.Error("Expected error for backup from a newer version, got nil")
+	}
+}
+
+func TestReadOnlyDSN(t *testing.T) {
+	// Characters that delimit a URI must not cut the path short
+	path := filepath.Join(t.TempDir(), "backup #1?.db")
+
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	if _, err := db.Exec(`CREATE TABLE houses (name TEXT)`); err != nil {
+		t.Fatalf("Error creating table: %v", err)
+	}
+	if _, err := db.Exec(`VACUUM INTO ?`, path); err != nil {
+		t.Fatalf("Error snapshotting database: %v", err)
+	}
+
+	readOnly, err := sql.Open("sqlite3", readOnlyDSN(path))
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	defer readOnly.Close()
+
+	var count int
+	if err := readOnly.QueryRow(`SELECT COUNT(*) FROM houses`).Scan(&count); err != nil {
+		t.Fatalf("Error reading database: %v", err)
+	}
+	if _, err := readOnly.Exec(`INSERT INTO houses (name) VALUES ('Written')`); err == nil {
+		t.Error("Expected error writing to a database opened read-only, got nil")
+	}
+}
+
+// setupLiveDB points the data directory at a temporary home and opens the
+// live database there with one house in it
+func setupLiveDB(t *testing.T, houseName string) *sql.DB {
+	t.Setenv("HOME", t.TempDir())
+	if err := os.MkdirAll(DataDir(), 0755); err != nil {
+		t.Fatalf("Error creating data directory: %v", err)
+	}
+
+	conn, err := openDatabase(Path())
+	if err != nil {
+		t.Fatalf("Error opening live database: %v", err)
+	}
+	dbInstance = conn
+	t.Cleanup(func() {
+		Close()
+		dbInstance = nil
+	})
+
+	_, err = conn.Exec(`INSERT INTO houses (name, street, number, country, zip_code, city)
+		VALUES (?, 'Street', '1', 'Country', '12345', 'City')`, houseName)
+	if err != nil {
+		t.Fatalf("Error inserting test house: %v", err)
+	}
+	return conn
+}
+
+// houseNames returns the names of all houses in the database
+func houseNames(t *testing.T, conn *sql.DB) []string {
+	rows, err := conn.Query(`SELECT name FROM houses ORDER BY name`)
+	if err != nil {
+		t.Fatalf("Error querying houses: %v", err)
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatalf("Error scanning house: %v", err)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestRestore_ReplacesDatabase(t *testing.T) {
+	live := setupLiveDB(t, "Live House")
+
+	// Take a backup, then change the live data
+	backupPath := filepath.Join(t.TempDir(), "backup.db")
+	if err := Backup(live, backupPath); err != nil {
+		t.Fatalf("Error backing up database: %v", err)
+	}
+	if _, err := live.Exec(`UPDATE houses SET name = 'Changed House'`); err != nil {
+		t.Fatalf("Error changing house: %v", err)
+	}
+
+	conn, err := Restore(backupPath)
+	if err != nil {
+		t.Fatalf("Error restoring backup: %v", err)
+	}
+	if conn != dbInstance {
+		t.Error("Expected the restored connection to become the live one")
+	}
+	if names := houseNames(t, conn); len(names) != 1 || names[0] != "Live House" {
+		t.Errorf("Expected the backed up house, got %v", names)
+	}
+	if _, err := os.Stat(Path() + ".bak"); !os.IsNotExist(err) {
+		t.Error("Expected the previous database file to be removed after restoring")
+	}
+}
+
+func TestRestore_KeepsDatabaseWhenRestoredFileFails(t *testing.T) {
+	setupLiveDB(t, "Live House")
+
+	// A backup that verifies but cannot be migrated: it claims schema
+	// version 1 but lacks the houses table later migrations alter
+	brokenPath := filepath.Join(t.TempDir(), "broken.db")
+	broken, err := sql.Open("sqlite3", brokenPath)
+	if err != nil {
+		t.Fatalf("Error creating broken backup: %v", err)
+	}
+	if err := ensureMigrationsTable(broken); err != nil {
+		t.Fatalf("Error creating migrations table: %v", err)
+	}
+	if _, err := broken.Exec(`INSERT INTO schema_migrations (version, name) VALUES (1, 'create_houses')`); err != nil {
+		t.Fatalf("Error recording migration: %v", err)
+	}
+	broken.Close()
+
+	conn, err := Restore(brokenPath)
+	if err == nil {
+		t.Fatal("Expected error restoring a backup that cannot be migrated, got nil")
+	}
+	if conn == nil {
+		t.Fatal("Expected a usable connection after the failed restore")
+	}
+	if conn != dbInstance {
+		t.Error("Expected the reopened connection to become the live one")
+	}
+	if names := houseNames(t, conn); len(names) != 1 || names[0] != "Live House" {
+		t.Errorf("Expected the previous data to be kept, got %v", names)
+	}
+	if _, err := os.Stat(Path() + ".bak"); !os.IsNotExist(err) {
+		t.Error("Expected the previous database file to be moved back")
+	}
+
+	// An invalid backup leaves the live connection open
+	if conn2, err := Restore(filepath.Join(t.TempDir(), "missing.db")); err == nil || conn2 != conn {
+		t.Errorf("Expected error and the unchanged connection, got %v (%v)", conn2, err)
+	}
+	if err := conn.Ping(); err != nil {
+		t.Errorf("Expected live connection to stay open, got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package db
+
+import "database/sql"
+
+// ChangeWatcher detects commits made to the database file by another
+// process, for example a second instance of the application sharing the
+// file over a network drive.
+//
+// It relies on PRAGMA data_version, which SQLite derives from the file
+// header and which only changes when a connection other than the one
+// asking has committed. openDatabase limits the pool to a single
+// connection, so the application's own writes never register as changes.
+type ChangeWatcher struct {
+	conn    *sql.DB
+	version int64
+}
+
+// NewChangeWatcher records the current state of the database so later
+// calls to Changed can compare against it
+func NewChangeWatcher(conn *sql.DB) (*ChangeWatcher, error) {
+	w := &ChangeWatcher{conn: conn}
+	version, err := w.dataVersion()
+	if err != nil {
+		return nil, err
+	}
+	w.version = version
+	return w, nil
+}
+
+// Changed reports whether another process has committed to the database
+// since the previous call (or since the watcher was created)
+func (w *ChangeWatcher) Changed() (bool, error) {
+	version, err := w.dataVersion()
+	if err != nil {
+		return false, err
+	}
+
+	changed := version != w.version
+	w.version = version
+	return changed, nil
+}
+
+// dataVersion reads SQLite's data version counter for the connection
+func (w *ChangeWatcher) dataVersion() (int64, error) {
+	var version int64
+	err := w.conn.QueryRow(`PRAGMA data_version`).Scan(&version)
+	return version, err
+}
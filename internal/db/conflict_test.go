@@ -0,0 +1,60 @@
+package db
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestChangeWatcher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shared.db")
+
+	// Two instances sharing the same database file
+	local, err := openDatabase(path)
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	defer local.Close()
+
+	remote, err := openDatabase(path)
+	if err != nil {
+		t.Fatalf("Error opening second connection: %v", err)
+	}
+	defer remote.Close()
+
+	watcher, err := NewChangeWatcher(local)
+	if err != nil {
+		t.Fatalf("Error creating watcher: %v", err)
+	}
+
+	// Own writes are not reported
+	if _, err := local.Exec(`INSERT INTO houses (name, street, number, country, zip_code, city) VALUES ('Local', 'Main St', '1', 'Germany', '10115', 'Berlin')`); err != nil {
+		t.Fatalf("Error writing locally: %v", err)
+	}
+	changed, err := watcher.Changed()
+	if err != nil {
+		t.Fatalf("Error checking for changes: %v", err)
+	}
+	if changed {
+		t.Error("Expected own write not to be reported as a change")
+	}
+
+	// Writes from the other instance are reported once
+	if _, err := remote.Exec(`INSERT INTO houses (name, street, number, country, zip_code, city) VALUES ('Remote', 'Main St', '2', 'Germany', '10115', 'Berlin')`); err != nil {
+		t.Fatalf("Error writing remotely: %v", err)
+	}
+	changed, err = watcher.Changed()
+	if err != nil {
+		t.Fatalf("Error checking for changes: %v", err)
+	}
+	if !changed {
+		t.Error("Expected write from another connection to be reported")
+	}
+
+	changed, err = watcher.Changed()
+	if err != nil {
+		t.Fatalf("Error checking for changes: %v", err)
+	}
+	if changed {
+		t.Error("Expected change to be reported only once")
+	}
+}
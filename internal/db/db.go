@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
@@ -19,33 +20,107 @@ var (
 func GetDB() *sql.DB {
 	once.Do(func() {
 		// Create data directory if it doesn't exist
-		dataDir := getDataDir()
+		dataDir := DataDir()
 		if err := os.MkdirAll(dataDir, 0755); err != nil {
 			log.Fatalf("Failed to create data directory: %v", err)
 		}
 
-		dbPath := filepath.Join(dataDir, "property_management.db")
-		db, err := sql.Open("sqlite3", dbPath)
+		db, err := openDatabase(Path())
 		if err != nil {
 			log.Fatalf("Failed to open database: %v", err)
 		}
 
-		// Test connection
-		if err := db.Ping(); err != nil {
-			log.Fatalf("Failed to connect to database: %v", err)
-		}
-
-		// Initialize the database schema
-		if err := initSchema(db); err != nil {
-			log.Fatalf("Failed to initialize database schema: %v", err)
-		}
-
 		dbInstance = db
 	})
 
 	return dbInstance
 }
 
+// Path returns the location of the database file
+func Path() string {
+	return filepath.Join(DataDir(), "property_management.db")
+}
+
+// openDatabase connects to the database file and brings its schema up to date
+func openDatabase(path string) (*sql.DB, error) {
+	// Wait for locks held by other instances instead of failing right away
+	db, err := sql.Open("sqlite3", path+"?_foreign_keys=on&_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+
+	// Use a single connection so ChangeWatcher only sees other processes' writes
+	db.SetMaxOpenConns(1)
+
+	// Test connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Keep a copy of databases written by older versions before changing their schema
+	if err := snapshotBeforeMigration(db, path); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// Bring the database schema up to date
+	if err := Migrate(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate database schema: %w", err)
+	}
+
+	return db, nil
+}
+
+// OpenInMemory returns an empty in-memory database with the current schema,
+// useful for staging data before it is merged into the live database
+func OpenInMemory() (*sql.DB, error) {
+	return openDatabase(":memory:")
+}
+
+// snapshotBeforeMigration snapshots an existing database that has pending migrations
+func snapshotBeforeMigration(db *sql.DB, path string) error {
+	// New databases have nothing worth keeping. Databases written before
+	// migrations existed have data but no recorded version, so look at the
+	// tables rather than the version.
+	hasData, err := hasUserTables(db)
+	if err != nil || !hasData {
+		return err
+	}
+
+	current, err := CurrentVersion(db)
+	if err != nil {
+		return err
+	}
+	latest, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if current >= latest {
+		return nil
+	}
+
+	dir := snapshotDirFor(path)
+	if _, err := takeSnapshot(db, dir, fmt.Sprintf("migration %d to %d", current, latest)); err != nil {
+		return err
+	}
+	return pruneSnapshots(dir, maxSnapshots)
+}
+
+// hasUserTables reports whether the database contains any application tables
+func hasUserTables(db *sql.DB) (bool, error) {
+	var exists bool
+	err := db.QueryRow(`
+		SELECT EXISTS(
+			SELECT 1 FROM sqlite_master
+			WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND name != 'schema_migrations'
+		)
+	`).Scan(&exists)
+	return exists, err
+}
+
 // Close closes the database connection
 func Close() {
 	if dbInstance != nil {
@@ -53,28 +128,8 @@ func Close() {
 	}
 }
 
-// Initialize database schema
-func initSchema(db *sql.DB) error {
-	// Create houses table
-	housesSchema := `
-	CREATE TABLE IF NOT EXISTS houses (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		name TEXT NOT NULL,
-		street TEXT NOT NULL,
-		number TEXT NOT NULL,
-		country TEXT NOT NULL,
-		zip_code TEXT NOT NULL,
-		city TEXT NOT NULL,
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-	);`
-
-	_, err := db.Exec(housesSchema)
-	return err
-}
-
-// getDataDir returns the path to the data directory
-func getDataDir() string {
+// DataDir returns the path to the data directory
+func DataDir() string {
 	// Get user's home directory
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -0,0 +1,120 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrNoBackup is returned by NewestBackup when the directory holds no backup
+var ErrNoBackup = errors.New("no backups found")
+
+// CheckIntegrity runs SQLite's integrity and foreign key checks on a connection
+func CheckIntegrity(conn *sql.DB) error {
+	var result string
+	if err := conn.QueryRow(`PRAGMA integrity_check`).Scan(&result); err != nil {
+		return err
+	}
+	if result != "ok" {
+		return fmt.Errorf("integrity check failed: %s", result)
+	}
+
+	rows, err := conn.Query(`PRAGMA foreign_key_check`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var table string
+		var rowID sql.NullInt64
+		var parent string
+		var fkID int
+		if err := rows.Scan(&table, &rowID, &parent, &fkID); err != nil {
+			return err
+		}
+		return fmt.Errorf("foreign key check failed: row %d of %s references a missing %s", rowID.Int64, table, parent)
+	}
+
+	return rows.Err()
+}
+
+// NewestBackup returns the most recently modified .db file in dir
+func NewestBackup(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return "", ErrNoBackup
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var newest string
+	var newestTime time.Time
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".db") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return "", err
+		}
+		if newest == "" || info.ModTime().After(newestTime) {
+			newest = filepath.Join(dir, entry.Name())
+			newestTime = info.ModTime()
+		}
+	}
+
+	if newest == "" {
+		return "", ErrNoBackup
+	}
+	return newest, nil
+}
+
+// TestRestore proves a backup is usable by restoring it into a temporary
+// file, migrating it to the current schema, and checking its integrity
+func TestRestore(backupPath string) error {
+	conn, cleanup, err := OpenCopy(backupPath)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	return CheckIntegrity(conn)
+}
+
+// OpenCopy opens a temporary copy of another database file of this
+// application, migrated to the current schema, leaving the original
+// untouched. The returned cleanup closes the connection and removes the copy.
+func OpenCopy(path string) (*sql.DB, func(), error) {
+	if err := VerifyBackup(path); err != nil {
+		return nil, nil, err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "property-management-copy")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	copyPath := filepath.Join(tmpDir, "copy.db")
+	if err := copyFile(path, copyPath); err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, nil, fmt.Errorf("failed to copy database: %w", err)
+	}
+
+	conn, err := openDatabase(copyPath)
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		conn.Close()
+		os.RemoveAll(tmpDir)
+	}
+	return conn, cleanup, nil
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCheckIntegrity(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Error migrating database: %v", err)
+	}
+
+	if err := CheckIntegrity(db); err != nil {
+		t.Errorf("Expected fresh database to pass, got %v", err)
+	}
+
+	// A meter pointing at a missing house fails the foreign key check
+	if _, err := db.Exec(`INSERT INTO meters (house_id, type, serial_number, unit) VALUES (9999, 'water', 'W-1', 'm³')`); err != nil {
+		t.Fatalf("Error inserting orphaned meter: %v", err)
+	}
+	if err := CheckIntegrity(db); err == nil {
+		t.Error("Expected foreign key violation to be reported, got nil")
+	}
+}
+
+func TestNewestBackupAndTestRestore(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Error migrating database: %v", err)
+	}
+
+	dir := t.TempDir()
+
+	// Empty or missing directory
+	if _, err := NewestBackup(dir); !errors.Is(err, ErrNoBackup) {
+		t.Errorf("Expected ErrNoBackup for directory without backups, got %v", err)
+	}
+	if _, err := NewestBackup(filepath.Join(dir, "missing")); !errors.Is(err, ErrNoBackup) {
+		t.Errorf("Expected ErrNoBackup for missing directory, got %v", err)
+	}
+
+	// Create an older valid backup and a newer unrelated file
+	older := filepath.Join(dir, "older.db")
+	newer := filepath.Join(dir, "newer.db")
+	if err := Backup(db, older); err != nil {
+		t.Fatalf("Error creating backup: %v", err)
+	}
+	if err := Backup(db, newer); err != nil {
+		t.Fatalf("Error creating backup: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0644); err != nil {
+		t.Fatalf("Error writing unrelated file: %v", err)
+	}
+	past := time.Now().Add(-48 * time.Hour)
+	if err := os.Chtimes(older, past, past); err != nil {
+		t.Fatalf("Error changing backup time: %v", err)
+	}
+
+	newest, err := NewestBackup(dir)
+	if err != nil {
+		t.Fatalf("Error finding newest backup: %v", err)
+	}
+	if newest != newer {
+		t.Errorf("Expected newest backup %s, got %s", newer, newest)
+	}
+
+	if err := TestRestore(newest); err != nil {
+		t.Errorf("Expected backup to restore, got %v", err)
+	}
+
+	// Corrupt the newest backup
+	if err := os.WriteFile(newer, []byte("corrupted"), 0644); err != nil {
+		t.Fatalf("Error corrupting backup: %v", err)
+	}
+	if err := TestRestore(newer); err == nil {
+		t.Error("Expected corrupted backup to fail, got nil")
+	}
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, func()) {
+	// Create a temporary file for the test database
+	tmpfile, err := os.CreateTemp("", "test_property_management.db")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	tmpfile.Close()
+
+	// Open the database connection
+	db, err := sql.Open("sqlite3", tmpfile.Name())
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+
+	// Return cleanup function
+	cleanup := func() {
+		db.Close()
+		os.Remove(tmpfile.Name())
+	}
+
+	return db, cleanup
+}
+
+func tableExists(t *testing.T, db *sql.DB, table string) bool {
+	var count int
+	err := db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`, table).Scan(&count)
+	if err != nil {
+		t.Fatalf("Error checking table %s: %v", table, err)
+	}
+	return count > 0
+}
+
+func TestMigrations_AreSequential(t *testing.T) {
+	migrations, err := Migrations()
+	if err != nil {
+		t.Fatalf("Error loading migrations: %v", err)
+	}
+
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			t.Errorf("Expected migration %d at position %d, got %d", i+1, i, migration.Version)
+		}
+		if migration.Down == "" {
+			t.Errorf("Migration %d (%s) has no down script", migration.Version, migration.Name)
+		}
+	}
+}
+
+func TestMigrate_UpAndDown(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	latest, err := LatestVersion()
+	if err != nil {
+		t.Fatalf("Error getting latest version: %v", err)
+	}
+
+	// Migrate a fresh database
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Error migrating database: %v", err)
+	}
+
+	version, err := CurrentVersion(db)
+	if err != nil {
+		t.Fatalf("Error getting current version: %v", err)
+	}
+	if version != latest {
+		t.Errorf("Expected version %d, got %d", latest, version)
+	}
+	if !tableExists(t, db, "houses") {
+		t.Error("Expected houses table to exist after migrating")
+	}
+
+	// Migrating again is a no-op
+	if err := Migrate(db); err != nil {
+		t.Errorf("Error re-running migrations: %v", err)
+	}
+
+	// Revert everything
+	if err := MigrateTo(db, 0); err != nil {
+		t.Fatalf("Error reverting migrations: %v", err)
+	}
+
+	version, err = CurrentVersion(db)
+	if err != nil {
+		t.Fatalf("Error getting current version: %v", err)
+	}
+	if version != 0 {
+		t.Errorf("Expected version 0 after reverting, got %d", version)
+	}
+	if tableExists(t, db, "houses") {
+		t.Error("Expected houses table to be dropped after reverting")
+	}
+}
+
+func TestMigrate_ExistingUnversionedDatabase(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	// Create the schema as written by releases before migrations existed
+	legacySchema := `
+	CREATE TABLE IF NOT EXISTS houses (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL,
+		street TEXT NOT NULL,
+		number TEXT NOT NULL,
+		country TEXT NOT NULL,
+		zip_code TEXT NOT NULL,
+		city TEXT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	INSERT INTO houses (name, street, number, country, zip_code, city)
+	VALUES ('Old House', 'Street', '1', 'Country', '12345', 'City');`
+
+	if _, err := db.Exec(legacySchema); err != nil {
+		t.Fatalf("Error creating legacy schema: %v", err)
+	}
+
+	// Migrating keeps existing data and adds new columns
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Error migrating legacy database: %v", err)
+	}
+
+	var name string
+	var saleDate sql.NullString
+	err := db.QueryRow(`SELECT name, sale_date FROM houses`).Scan(&name, &saleDate)
+	if err != nil {
+		t.Fatalf("Error reading migrated house: %v", err)
+	}
+	if name != "Old House" || saleDate.Valid {
+		t.Errorf("Unexpected migrated house: %s, %v", name, saleDate)
+	}
+}
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS houses (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	name TEXT NOT NULL,
+	street TEXT NOT NULL,
+	number TEXT NOT NULL,
+	country TEXT NOT NULL,
+	zip_code TEXT NOT NULL,
+	city TEXT NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1 @@
+ALTER TABLE houses ADD COLUMN sale_date TIMESTAMP NULL;
@@ -0,0 +1,19 @@
+-- At most one external manager (Hausverwaltung) per house
+CREATE TABLE IF NOT EXISTS property_managers (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	house_id INTEGER NOT NULL UNIQUE REFERENCES houses(id) ON DELETE CASCADE,
+	company_name TEXT NOT NULL,
+	contact_name TEXT NOT NULL DEFAULT '',
+	street TEXT NOT NULL DEFAULT '',
+	number TEXT NOT NULL DEFAULT '',
+	country TEXT NOT NULL DEFAULT '',
+	zip_code TEXT NOT NULL DEFAULT '',
+	city TEXT NOT NULL DEFAULT '',
+	phone TEXT NOT NULL DEFAULT '',
+	email TEXT NOT NULL DEFAULT '',
+	mandate_start TIMESTAMP NULL,
+	mandate_end TIMESTAMP NULL,
+	use_for_correspondence BOOLEAN NOT NULL DEFAULT 0,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS meters (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	house_id INTEGER NOT NULL REFERENCES houses(id) ON DELETE CASCADE,
+	type TEXT NOT NULL,
+	serial_number TEXT NOT NULL,
+	location TEXT NOT NULL DEFAULT '',
+	unit TEXT NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_meters_house_id ON meters(house_id);
+
+-- At most one reading per meter and day
+CREATE TABLE IF NOT EXISTS meter_readings (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	meter_id INTEGER NOT NULL REFERENCES meters(id) ON DELETE CASCADE,
+	reading_date TIMESTAMP NOT NULL,
+	value REAL NOT NULL,
+	note TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (meter_id, reading_date)
+);
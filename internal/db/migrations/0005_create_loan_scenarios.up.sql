@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS loan_scenarios (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	house_id INTEGER NOT NULL REFERENCES houses(id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	outstanding_balance REAL NOT NULL,
+	current_rate REAL NOT NULL,
+	current_remaining_months INTEGER NOT NULL,
+	offer_rate REAL NOT NULL,
+	offer_term_months INTEGER NOT NULL,
+	offer_fees REAL NOT NULL DEFAULT 0,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_loan_scenarios_house_id ON loan_scenarios(house_id);
@@ -0,0 +1,3 @@
+ALTER TABLE houses DROP COLUMN land_value_share;
+ALTER TABLE houses DROP COLUMN incidental_costs;
+ALTER TABLE houses DROP COLUMN purchase_price;
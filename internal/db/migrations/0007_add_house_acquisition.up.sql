@@ -0,0 +1,3 @@
+ALTER TABLE houses ADD COLUMN purchase_price REAL NOT NULL DEFAULT 0;
+ALTER TABLE houses ADD COLUMN incidental_costs REAL NOT NULL DEFAULT 0;
+ALTER TABLE houses ADD COLUMN land_value_share REAL NOT NULL DEFAULT 0;
@@ -0,0 +1,10 @@
+-- Every outgoing email, including failed attempts
+CREATE TABLE IF NOT EXISTS email_log (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	recipient TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	attachments TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL,
+	error TEXT NOT NULL DEFAULT '',
+	sent_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,14 @@
+-- Files attached to any entity; the file itself lives in the document vault
+CREATE TABLE IF NOT EXISTS documents (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	entity_type TEXT NOT NULL,
+	entity_id INTEGER NOT NULL,
+	file_name TEXT NOT NULL,
+	stored_path TEXT NOT NULL UNIQUE,
+	content_type TEXT NOT NULL DEFAULT '',
+	size INTEGER NOT NULL DEFAULT 0,
+	description TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_documents_entity ON documents(entity_type, entity_id);
@@ -0,0 +1,9 @@
+-- Results of the scheduled database integrity and backup verification runs
+CREATE TABLE IF NOT EXISTS integrity_checks (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	checked_at TIMESTAMP NOT NULL,
+	database_ok BOOLEAN NOT NULL,
+	backup_path TEXT NOT NULL DEFAULT '',
+	backup_ok BOOLEAN NOT NULL,
+	error TEXT NOT NULL DEFAULT ''
+);
@@ -0,0 +1,6 @@
+-- Features explicitly switched on or off; features without a row use their default
+CREATE TABLE IF NOT EXISTS feature_flags (
+	name TEXT PRIMARY KEY,
+	enabled BOOLEAN NOT NULL,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,9 @@
+-- History of automatic and on-demand backups taken into the backup directory
+CREATE TABLE IF NOT EXISTS backup_runs (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	started_at TIMESTAMP NOT NULL,
+	scheduled BOOLEAN NOT NULL,
+	path TEXT NOT NULL DEFAULT '',
+	size INTEGER NOT NULL DEFAULT 0,
+	error TEXT NOT NULL DEFAULT ''
+);
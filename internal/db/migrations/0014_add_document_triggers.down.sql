@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS documents_delete_with_meter;
+DROP TRIGGER IF EXISTS documents_delete_with_house;
+DROP TRIGGER IF EXISTS documents_require_record_on_update;
+DROP TRIGGER IF EXISTS documents_require_record_on_insert;
@@ -0,0 +1,35 @@
+-- Documents refer to their record by entity type and ID rather than a foreign
+-- key, so these triggers keep the link intact at the database level
+
+CREATE TRIGGER IF NOT EXISTS documents_require_record_on_insert
+BEFORE INSERT ON documents
+WHEN NOT (
+	(NEW.entity_type = 'house' AND EXISTS (SELECT 1 FROM houses WHERE id = NEW.entity_id))
+	OR (NEW.entity_type = 'meter' AND EXISTS (SELECT 1 FROM meters WHERE id = NEW.entity_id))
+)
+BEGIN
+	SELECT RAISE(ABORT, 'document references a missing record');
+END;
+
+CREATE TRIGGER IF NOT EXISTS documents_require_record_on_update
+BEFORE UPDATE OF entity_type, entity_id ON documents
+WHEN NOT (
+	(NEW.entity_type = 'house' AND EXISTS (SELECT 1 FROM houses WHERE id = NEW.entity_id))
+	OR (NEW.entity_type = 'meter' AND EXISTS (SELECT 1 FROM meters WHERE id = NEW.entity_id))
+)
+BEGIN
+	SELECT RAISE(ABORT, 'document references a missing record');
+END;
+
+-- Also fires for meters removed by the cascade from their house
+CREATE TRIGGER IF NOT EXISTS documents_delete_with_house
+AFTER DELETE ON houses
+BEGIN
+	DELETE FROM documents WHERE entity_type = 'house' AND entity_id = OLD.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS documents_delete_with_meter
+AFTER DELETE ON meters
+BEGIN
+	DELETE FROM documents WHERE entity_type = 'meter' AND entity_id = OLD.id;
+END;
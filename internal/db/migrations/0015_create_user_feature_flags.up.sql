@@ -0,0 +1,8 @@
+-- Features switched on or off for a single user; these take precedence over feature_flags
+CREATE TABLE IF NOT EXISTS user_feature_flags (
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	enabled BOOLEAN NOT NULL,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (user_id, name)
+);
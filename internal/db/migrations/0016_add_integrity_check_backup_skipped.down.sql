@@ -0,0 +1 @@
+ALTER TABLE integrity_checks DROP COLUMN backup_skipped;
@@ -0,0 +1 @@
+ALTER TABLE integrity_checks ADD COLUMN backup_skipped BOOLEAN NOT NULL DEFAULT 0;
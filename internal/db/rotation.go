@@ -0,0 +1,53 @@
+package db
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// automaticBackupPrefix marks backups taken by the scheduler; only these are rotated
+const automaticBackupPrefix = "auto_"
+
+// AutomaticBackup writes a timestamped backup into dir and removes the oldest
+// automatic backups beyond keep. Backups the user saved by hand are never removed.
+func AutomaticBackup(conn *sql.DB, dir string, keep int) (string, error) {
+	path := filepath.Join(dir, automaticBackupPrefix+time.Now().Format("20060102-150405")+".db")
+	if err := Backup(conn, path); err != nil {
+		return "", err
+	}
+
+	if err := rotateBackups(dir, keep); err != nil {
+		return path, err
+	}
+
+	return path, nil
+}
+
+// rotateBackups removes all but the newest keep automatic backups in dir
+func rotateBackups(dir string, keep int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	// Timestamped names sort chronologically
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasPrefix(name, automaticBackupPrefix) && strings.HasSuffix(name, ".db") {
+			backups = append(backups, name)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+
+	for i := keep; i < len(backups); i++ {
+		if err := os.Remove(filepath.Join(dir, backups[i])); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
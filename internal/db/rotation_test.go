@@ -0,0 +1,47 @@
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAutomaticBackupRotation(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Error migrating database: %v", err)
+	}
+
+	dir := t.TempDir()
+
+	// Older automatic backups and one saved by hand
+	for _, name := range []string{"auto_20240101-000000.db", "auto_20240102-000000.db", "auto_20240103-000000.db", "manual.db"} {
+		if err := Backup(db, filepath.Join(dir, name)); err != nil {
+			t.Fatalf("Error creating backup: %v", err)
+		}
+	}
+
+	path, err := AutomaticBackup(db, dir, 2)
+	if err != nil {
+		t.Fatalf("Error taking automatic backup: %v", err)
+	}
+	if err := VerifyBackup(path); err != nil {
+		t.Errorf("Expected automatic backup to verify, got %v", err)
+	}
+
+	// The new backup and the newest old one remain, the manual one is untouched
+	for name, want := range map[string]bool{
+		filepath.Base(path):       true,
+		"auto_20240103-000000.db": true,
+		"auto_20240102-000000.db": false,
+		"auto_20240101-000000.db": false,
+		"manual.db":               true,
+	} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if exists := err == nil; exists != want {
+			t.Errorf("Expected %s to exist: %v, got %v", name, want, exists)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxSnapshots is how many automatic snapshots are kept before the oldest are removed
+const maxSnapshots = 20
+
+// snapshotTimeLayout is the timestamp at the start of every snapshot file name
+const snapshotTimeLayout = "20060102-150405.000000"
+
+// snapshotReasonPattern strips characters that do not belong in a file name
+var snapshotReasonPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// SnapshotInfo describes an automatic snapshot taken before a risky operation
+type SnapshotInfo struct {
+	Path      string    `json:"path"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"createdAt"`
+	Size      int64     `json:"size"`
+}
+
+// SnapshotDir returns the directory holding the automatic snapshots of the live database
+func SnapshotDir() string {
+	return snapshotDirFor(Path())
+}
+
+// snapshotDirFor returns the snapshot directory of the database file at dbPath
+func snapshotDirFor(dbPath string) string {
+	return filepath.Join(filepath.Dir(dbPath), "snapshots")
+}
+
+// Snapshot backs up the live database before a destructive operation and
+// returns the path of the snapshot, which can be restored to roll back.
+// Callers prune old snapshots with PruneSnapshots once the operation is
+// done, so the snapshot being restored during a rollback is never removed.
+func Snapshot(conn *sql.DB, reason string) (string, error) {
+	return takeSnapshot(conn, SnapshotDir(), reason)
+}
+
+// PruneSnapshots removes the oldest automatic snapshots of the live database
+func PruneSnapshots() error {
+	return pruneSnapshots(SnapshotDir(), maxSnapshots)
+}
+
+// takeSnapshot writes a snapshot of conn into dir
+func takeSnapshot(conn *sql.DB, dir, reason string) (string, error) {
+	slug := strings.Trim(snapshotReasonPattern.ReplaceAllString(strings.ToLower(reason), "-"), "-")
+	name := time.Now().Format(snapshotTimeLayout) + "_" + slug + ".db"
+	path := filepath.Join(dir, name)
+
+	if err := Backup(conn, path); err != nil {
+		return "", fmt.Errorf("failed to snapshot database before %s: %w", reason, err)
+	}
+
+	return path, nil
+}
+
+// ListSnapshots returns the automatic snapshots of the live database, newest first
+func ListSnapshots() ([]SnapshotInfo, error) {
+	return listSnapshots(SnapshotDir())
+}
+
+// listSnapshots returns the snapshots in dir, newest first
+func listSnapshots(dir string) ([]SnapshotInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []SnapshotInfo{}, nil
+		}
+		return nil, err
+	}
+
+	snapshots := []SnapshotInfo{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".db") {
+			continue
+		}
+
+		stamp, reason, ok := strings.Cut(strings.TrimSuffix(name, ".db"), "_")
+		if !ok {
+			continue
+		}
+		createdAt, err := time.ParseInLocation(snapshotTimeLayout, stamp, time.Local)
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, SnapshotInfo{
+			Path:      filepath.Join(dir, name),
+			Reason:    strings.ReplaceAll(reason, "-", " "),
+			CreatedAt: createdAt,
+			Size:      info.Size(),
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+	})
+
+	return snapshots, nil
+}
+
+// pruneSnapshots removes all but the newest keep snapshots in dir
+func pruneSnapshots(dir string, keep int) error {
+	snapshots, err := listSnapshots(dir)
+	if err != nil {
+		return err
+	}
+
+	for i := keep; i < len(snapshots); i++ {
+		if err := os.Remove(snapshots[i].Path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsSnapshot reports whether path points into the live database's snapshot directory
+func IsSnapshot(path string) bool {
+	dir, err := filepath.Abs(SnapshotDir())
+	if err != nil {
+		return false
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	return filepath.Dir(abs) == dir
+}
@@ -0,0 +1,197 @@
+package db
+
+import (
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTakeSnapshotAndPrune(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	if err := Migrate(db); err != nil {
+		t.Fatalf("Error migrating database: %v", err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "snapshots")
+
+	// Nothing taken yet
+	snapshots, err := listSnapshots(dir)
+	if err != nil {
+		t.Fatalf("Error listing snapshots: %v", err)
+	}
+	if len(snapshots) != 0 {
+		t.Errorf("Expected no snapshots, got %d", len(snapshots))
+	}
+
+	path, err := takeSnapshot(db, dir, "Delete house 3")
+	if err != nil {
+		t.Fatalf("Error taking snapshot: %v", err)
+	}
+	if !strings.HasSuffix(path, "_delete-house-3.db") {
+		t.Errorf("Expected reason in file name, got %s", path)
+	}
+	if err := VerifyBackup(path); err != nil {
+		t.Errorf("Expected snapshot to verify, got %v", err)
+	}
+
+	snapshots, err = listSnapshots(dir)
+	if err != nil {
+		t.Fatalf("Error listing snapshots: %v", err)
+	}
+	if len(snapshots) != 1 || snapshots[0].Reason != "delete house 3" || snapshots[0].Path != path {
+		t.Errorf("Expected the snapshot to be listed with its reason, got %+v", snapshots)
+	}
+
+	// Only the newest snapshots are kept
+	for i := 0; i < 3; i++ {
+		if _, err := takeSnapshot(db, dir, "restore"); err != nil {
+			t.Fatalf("Error taking snapshot: %v", err)
+		}
+	}
+	if err := pruneSnapshots(dir, 2); err != nil {
+		t.Fatalf("Error pruning snapshots: %v", err)
+	}
+	snapshots, err = listSnapshots(dir)
+	if err != nil {
+		t.Fatalf("Error listing snapshots: %v", err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("Expected 2 snapshots after pruning, got %d", len(snapshots))
+	}
+	if snapshots[0].Path == path || snapshots[1].Path == path {
+		t.Error("Expected the oldest snapshot to be pruned")
+	}
+	if !snapshots[0].CreatedAt.After(snapshots[1].CreatedAt) {
+		t.Error("Expected snapshots newest first")
+	}
+}
+
+func TestOpenDatabaseSnapshotsBeforeMigration(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "old.db")
+
+	// A database created by an older version
+	old, err := openDatabase(path)
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	if err := MigrateTo(old, 1); err != nil {
+		t.Fatalf("Error migrating down: %v", err)
+	}
+	old.Close()
+
+	conn, err := openDatabase(path)
+	if err != nil {
+		t.Fatalf("Error reopening database: %v", err)
+	}
+	defer conn.Close()
+
+	snapshots, err := listSnapshots(snapshotDirFor(path))
+	if err != nil {
+		t.Fatalf("Error listing snapshots: %v", err)
+	}
+	if len(snapshots) != 1 || !strings.HasPrefix(snapshots[0].Reason, "migration 1 to ") {
+		t.Fatalf("Expected one pre-migration snapshot, got %+v", snapshots)
+	}
+
+	// The snapshot still has the old schema
+	snapshot, err := sql.Open("sqlite3", readOnlyDSN(snapshots[0].Path))
+	if err != nil {
+		t.Fatalf("Error opening snapshot: %v", err)
+	}
+	defer snapshot.Close()
+	if version, err := CurrentVersion(snapshot); err != nil || version != 1 {
+		t.Errorf("Expected snapshot at schema version 1, got %d (%v)", version, err)
+	}
+
+	// Opening an up-to-date database takes no further snapshot
+	conn.Close()
+	conn, err = openDatabase(path)
+	if err != nil {
+		t.Fatalf("Error reopening database: %v", err)
+	}
+	snapshots, err = listSnapshots(snapshotDirFor(path))
+	if err != nil {
+		t.Fatalf("Error listing snapshots: %v", err)
+	}
+	if len(snapshots) != 1 {
+		t.Errorf("Expected no snapshot for an up-to-date database, got %d", len(snapshots))
+	}
+}
+
+func TestOpenDatabaseSnapshotsBaselineDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.db")
+
+	// The first release created the houses table without recording a schema version
+	baseline, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("Error creating baseline database: %v", err)
+	}
+	_, err = baseline.Exec(`
+	CREATE TABLE IF NOT EXISTS houses (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL,
+		street TEXT NOT NULL,
+		number TEXT NOT NULL,
+		country TEXT NOT NULL,
+		zip_code TEXT NOT NULL,
+		city TEXT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	INSERT INTO houses (name, street, number, country, zip_code, city)
+	VALUES ('Old House', 'Street', '1', 'Country', '12345', 'City');`)
+	baseline.Close()
+	if err != nil {
+		t.Fatalf("Error creating baseline schema: %v", err)
+	}
+
+	conn, err := openDatabase(path)
+	if err != nil {
+		t.Fatalf("Error opening baseline database: %v", err)
+	}
+	defer conn.Close()
+
+	snapshots, err := listSnapshots(snapshotDirFor(path))
+	if err != nil {
+		t.Fatalf("Error listing snapshots: %v", err)
+	}
+	if len(snapshots) != 1 || !strings.HasPrefix(snapshots[0].Reason, "migration 0 to ") {
+		t.Fatalf("Expected one pre-migration snapshot, got %+v", snapshots)
+	}
+
+	// The snapshot holds the data in its original schema
+	snapshot, err := sql.Open("sqlite3", readOnlyDSN(snapshots[0].Path))
+	if err != nil {
+		t.Fatalf("Error opening snapshot: %v", err)
+	}
+	defer snapshot.Close()
+
+	var name string
+	if err := snapshot.QueryRow(`SELECT name FROM houses`).Scan(&name); err != nil || name != "Old House" {
+		t.Errorf("Expected 'Old House' in snapshot, got %q (%v)", name, err)
+	}
+	if _, err := snapshot.Exec(`SELECT sale_date FROM houses`); err == nil {
+		t.Error("Expected snapshot without columns added by migrations")
+	}
+}
+
+func TestOpenDatabaseNewFileTakesNoSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "new.db")
+
+	conn, err := openDatabase(path)
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	defer conn.Close()
+
+	snapshots, err := listSnapshots(snapshotDirFor(path))
+	if err != nil {
+		t.Fatalf("Error listing snapshots: %v", err)
+	}
+	if len(snapshots) != 0 {
+		t.Errorf("Expected no snapshot for a new database, got %d", len(snapshots))
+	}
+}
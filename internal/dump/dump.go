@@ -0,0 +1,205 @@
+package dump
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"property-management/internal/models"
+	"property-management/internal/repository"
+)
+
+// Format identifies data dumps written by this application
+const Format = "property-management-dump"
+
+// Version is the dump layout written by this version of the application
+const Version = 1
+
+// File is the on-disk representation of a full data dump. Records are
+// nested under their house instead of referring to each other by ID, so a
+// dump can be read by other tools and loaded into any database.
+type File struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+	Houses     []House   `json:"houses"`
+}
+
+// House is a house together with everything attached to it
+type House struct {
+	models.House
+	PropertyManager *models.PropertyManager `json:"propertyManager,omitempty"`
+	Meters          []Meter                 `json:"meters"`
+	LoanScenarios   []models.LoanScenario   `json:"loanScenarios"`
+}
+
+// Meter is a meter together with its readings
+type Meter struct {
+	models.Meter
+	Readings []models.MeterReading `json:"readings"`
+}
+
+// Export collects all houses and their records from the database
+func Export(db *sql.DB) (*File, error) {
+	houseRepository := repository.NewHouseRepository(db)
+	propertyManagerRepository := repository.NewPropertyManagerRepository(db)
+	meterRepository := repository.NewMeterRepository(db)
+	meterReadingRepository := repository.NewMeterReadingRepository(db)
+	loanScenarioRepository := repository.NewLoanScenarioRepository(db)
+
+	houses, err := houseRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{
+		Format:     Format,
+		Version:    Version,
+		ExportedAt: time.Now(),
+		Houses:     []House{},
+	}
+
+	for _, house := range houses {
+		entry := House{
+			House:         house,
+			Meters:        []Meter{},
+			LoanScenarios: []models.LoanScenario{},
+		}
+
+		// A missing property manager is not an error
+		manager, err := propertyManagerRepository.GetByHouseID(house.ID)
+		if err != nil && !errors.Is(err, repository.ErrPropertyManagerNotFound) {
+			return nil, err
+		}
+		entry.PropertyManager = manager
+
+		meters, err := meterRepository.GetByHouseID(house.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, meter := range meters {
+			readings, err := meterReadingRepository.GetByMeterID(meter.ID)
+			if err != nil {
+				return nil, err
+			}
+			if readings == nil {
+				readings = []models.MeterReading{}
+			}
+			entry.Meters = append(entry.Meters, Meter{Meter: meter, Readings: readings})
+		}
+
+		scenarios, err := loanScenarioRepository.GetByHouseID(house.ID)
+		if err != nil {
+			return nil, err
+		}
+		entry.LoanScenarios = append(entry.LoanScenarios, scenarios...)
+
+		file.Houses = append(file.Houses, entry)
+	}
+
+	return file, nil
+}
+
+// Write exports all houses and their records to a JSON file at path
+func Write(path string, db *sql.DB) error {
+	file, err := Export(db)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+// Read loads and validates a dump file
+func Read(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file File
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid data dump: %w", err)
+	}
+
+	if file.Format != Format {
+		return nil, errors.New("file is not a property management data dump")
+	}
+	if file.Version < 1 || file.Version > Version {
+		return nil, fmt.Errorf("unsupported data dump version %d", file.Version)
+	}
+
+	return &file, nil
+}
+
+// Load inserts the contents of a dump into db, assigning new IDs. Records
+// are validated by the repositories just like records entered by hand.
+func Load(db *sql.DB, file *File) error {
+	houseRepository := repository.NewHouseRepository(db)
+	propertyManagerRepository := repository.NewPropertyManagerRepository(db)
+	meterRepository := repository.NewMeterRepository(db)
+	meterReadingRepository := repository.NewMeterReadingRepository(db)
+	loanScenarioRepository := repository.NewLoanScenarioRepository(db)
+
+	for _, entry := range file.Houses {
+		house := models.NewHouse(entry.Name, entry.Street, entry.Number, entry.Country, entry.ZipCode, entry.City)
+		if err := houseRepository.Create(house); err != nil {
+			return fmt.Errorf("house %s: %w", entry.Name, err)
+		}
+		house.PurchasePrice = entry.PurchasePrice
+		house.IncidentalCosts = entry.IncidentalCosts
+		house.LandValueShare = entry.LandValueShare
+		if err := houseRepository.UpdateAcquisition(house); err != nil {
+			return fmt.Errorf("house %s: %w", entry.Name, err)
+		}
+
+		if entry.PropertyManager != nil {
+			manager := *entry.PropertyManager
+			manager.ID = 0
+			manager.HouseID = house.ID
+			if err := propertyManagerRepository.Save(&manager); err != nil {
+				return fmt.Errorf("property manager of house %s: %w", entry.Name, err)
+			}
+		}
+
+		for _, entryMeter := range entry.Meters {
+			meter := models.NewMeter(house.ID, entryMeter.Type, entryMeter.SerialNumber, entryMeter.Location, entryMeter.Unit)
+			if err := meterRepository.Create(meter); err != nil {
+				return fmt.Errorf("meter %s of house %s: %w", entryMeter.SerialNumber, entry.Name, err)
+			}
+
+			for _, entryReading := range entryMeter.Readings {
+				reading := models.NewMeterReading(meter.ID, entryReading.ReadingDate, entryReading.Value, entryReading.Note)
+				if err := meterReadingRepository.Create(reading); err != nil {
+					return fmt.Errorf("reading of meter %s in house %s: %w", entryMeter.SerialNumber, entry.Name, err)
+				}
+			}
+		}
+
+		for _, entryScenario := range entry.LoanScenarios {
+			scenario := entryScenario
+			scenario.ID = 0
+			scenario.HouseID = house.ID
+			if err := loanScenarioRepository.Create(&scenario); err != nil {
+				return fmt.Errorf("loan scenario %s of house %s: %w", entryScenario.Name, entry.Name, err)
+			}
+		}
+
+		// Sold houses become read-only, so they are archived last
+		if entry.SaleDate != nil {
+			if err := houseRepository.Archive(house.ID, *entry.SaleDate); err != nil {
+				return fmt.Errorf("house %s: %w", entry.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package dump
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"property-management/internal/models"
+	"property-management/internal/repository"
+	"property-management/internal/testutil"
+)
+
+func TestWriteReadLoad(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	// A sold house with a meter, readings, a property manager and a loan scenario
+	house := testutil.CreateHouse(t, db)
+	meter := testutil.CreateMeter(t, db, house.ID)
+	readings := repository.NewMeterReadingRepository(db)
+	for i, value := range []float64{100, 112.5} {
+		reading := models.NewMeterReading(meter.ID, testutil.Date(2024, time.Month(i+1), 1), value, "")
+		if err := readings.Create(reading); err != nil {
+			t.Fatalf("Error creating reading: %v", err)
+		}
+	}
+	manager := &models.PropertyManager{HouseID: house.ID, CompanyName: "Verwaltung GmbH"}
+	if err := repository.NewPropertyManagerRepository(db).Save(manager); err != nil {
+		t.Fatalf("Error saving property manager: %v", err)
+	}
+	scenario := &models.LoanScenario{
+		HouseID:                house.ID,
+		Name:                   "Bank offer",
+		OutstandingBalance:     200000,
+		CurrentRate:            3.5,
+		CurrentRemainingMonths: 120,
+		OfferRate:              2.9,
+		OfferTermMonths:        120,
+	}
+	if err := repository.NewLoanScenarioRepository(db).Create(scenario); err != nil {
+		t.Fatalf("Error creating loan scenario: %v", err)
+	}
+	if err := repository.NewHouseRepository(db).Archive(house.ID, testutil.Date(2024, time.June, 30)); err != nil {
+		t.Fatalf("Error archiving house: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "dump.json")
+	if err := Write(path, db); err != nil {
+		t.Fatalf("Error writing dump: %v", err)
+	}
+
+	file, err := Read(path)
+	if err != nil {
+		t.Fatalf("Error reading dump: %v", err)
+	}
+	if len(file.Houses) != 1 || len(file.Houses[0].Meters) != 1 || len(file.Houses[0].Meters[0].Readings) != 2 {
+		t.Fatalf("Unexpected dump contents: %+v", file)
+	}
+
+	// Load into a fresh database and compare
+	other := testutil.NewDB(t)
+	if err := Load(other, file); err != nil {
+		t.Fatalf("Error loading dump: %v", err)
+	}
+
+	loaded, err := Export(other)
+	if err != nil {
+		t.Fatalf("Error exporting loaded data: %v", err)
+	}
+	if len(loaded.Houses) != 1 {
+		t.Fatalf("Expected 1 loaded house, got %d", len(loaded.Houses))
+	}
+	loadedHouse := loaded.Houses[0]
+	if !loadedHouse.IsArchived() || loadedHouse.PropertyManager == nil || len(loadedHouse.LoanScenarios) != 1 {
+		t.Errorf("Expected archived house with property manager and loan scenario, got %+v", loadedHouse)
+	}
+	if len(loadedHouse.Meters) != 1 || len(loadedHouse.Meters[0].Readings) != 2 || loadedHouse.Meters[0].Readings[1].Value != 112.5 {
+		t.Errorf("Expected meter with both readings, got %+v", loadedHouse.Meters)
+	}
+}
+
+func TestExport_ReadFailure(t *testing.T) {
+	db := testutil.NewDB(t)
+	testutil.CreateHouse(t, db)
+
+	// Reading the property manager fails for a reason other than its absence
+	if _, err := db.Exec(`ALTER TABLE property_managers RENAME TO property_managers_old`); err != nil {
+		t.Fatalf("Error renaming table: %v", err)
+	}
+
+	if _, err := Export(db); err == nil {
+		t.Error("Expected error exporting an unreadable property manager, got nil")
+	}
+}
+
+func TestRead(t *testing.T) {
+	dir := t.TempDir()
+
+	// Test files that are not dumps
+	for name, content := range map[string]string{
+		"invalid.json": "{",
+		"other.json":   `{"format": "something-else", "version": 1}`,
+		"future.json":  `{"format": "property-management-dump", "version": 99}`,
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Error writing test file: %v", err)
+		}
+		if _, err := Read(path); err == nil {
+			t.Errorf("Expected error for %s, got nil", name)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package guides
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//go:embed guides.json
+var guidesFile []byte
+
+// Step is a single instruction within a guide
+type Step struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// Guide is a step-by-step walkthrough of a workflow supported by the
+// application. Guides ship with the binary so they always describe the
+// features of the running version.
+type Guide struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Summary string `json:"summary"`
+	Steps   []Step `json:"steps"`
+}
+
+// All returns the embedded guides in display order
+func All() ([]Guide, error) {
+	var guides []Guide
+	if err := json.Unmarshal(guidesFile, &guides); err != nil {
+		return nil, fmt.Errorf("invalid guides: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, guide := range guides {
+		if err := guide.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[guide.ID] {
+			return nil, fmt.Errorf("duplicate guide %q", guide.ID)
+		}
+		seen[guide.ID] = true
+	}
+
+	return guides, nil
+}
+
+// Validate ensures a guide is complete
+func (g *Guide) Validate() error {
+	// Identification validation
+	if strings.TrimSpace(g.ID) == "" {
+		return errors.New("guide ID cannot be empty")
+	}
+	if strings.TrimSpace(g.Title) == "" {
+		return fmt.Errorf("guide %q has no title", g.ID)
+	}
+
+	// Step validation
+	if len(g.Steps) == 0 {
+		return fmt.Errorf("guide %q has no steps", g.ID)
+	}
+	for i, step := range g.Steps {
+		if strings.TrimSpace(step.Title) == "" || strings.TrimSpace(step.Body) == "" {
+			return fmt.Errorf("step %d of guide %q is incomplete", i+1, g.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package guides
+
+import "testing"
+
+func TestAll(t *testing.T) {
+	guides, err := All()
+	if err != nil {
+		t.Fatalf("Error loading embedded guides: %v", err)
+	}
+
+	if len(guides) == 0 {
+		t.Fatal("Expected embedded guides, got none")
+	}
+}
+
+func TestGuideValidate(t *testing.T) {
+	valid := Guide{
+		ID:    "example",
+		Title: "Example",
+		Steps: []Step{{Title: "First", Body: "Do something"}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected valid guide, got %v", err)
+	}
+
+	noSteps := valid
+	noSteps.Steps = nil
+	if err := noSteps.Validate(); err == nil {
+		t.Error("Expected error for guide without steps, got nil")
+	}
+
+	incompleteStep := valid
+	incompleteStep.Steps = []Step{{Title: "First"}}
+	if err := incompleteStep.Validate(); err == nil {
+		t.Error("Expected error for step without body, got nil")
+	}
+
+	noID := valid
+	noID.ID = " "
+	if err := noID.Validate(); err == nil {
+		t.Error("Expected error for guide without ID, got nil")
+	}
+}
@@ -0,0 +1,224 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds the connection data of the outgoing mail server
+type Config struct {
+	Host        string
+	Port        int
+	Username    string
+	Password    string
+	FromAddress string
+	FromName    string
+}
+
+// Message is a plain-text email with optional file attachments
+type Message struct {
+	To          string
+	ReplyTo     string
+	Subject     string
+	Body        string
+	Attachments []string
+}
+
+// Validate ensures the configuration is complete enough to send mail
+func (c Config) Validate() error {
+	if strings.TrimSpace(c.Host) == "" {
+		return errors.New("no SMTP server configured")
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return errors.New("SMTP port must be between 1 and 65535")
+	}
+	if _, err := mail.ParseAddress(c.FromAddress); err != nil {
+		return errors.New("sender email address is invalid")
+	}
+	return nil
+}
+
+// Send delivers the message through the configured SMTP server. Port 465 uses
+// implicit TLS; all other ports upgrade via STARTTLS when the server offers it.
+func Send(config Config, message Message) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	data, err := BuildMessage(config, message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// The SMTP envelope takes bare addresses without display names
+	from, err := mail.ParseAddress(config.FromAddress)
+	if err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	var auth smtp.Auth
+	if config.Username != "" {
+		auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+
+	if config.Port != 465 {
+		return smtp.SendMail(addr, auth, from.Address, []string{to.Address}, data)
+	}
+
+	conn, err := tls.Dial("tcp", addr, &tls.Config{ServerName: config.Host})
+	if err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(data); err != nil {
+		writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// BuildMessage renders the message as a MIME document ready for SMTP delivery
+func BuildMessage(config Config, message Message, date time.Time) ([]byte, error) {
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient %q", message.To)
+	}
+	from, err := mail.ParseAddress(config.FromAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender %q", config.FromAddress)
+	}
+	if config.FromName != "" {
+		from.Name = config.FromName
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, "From", from.String())
+	writeHeader(&buf, "To", to.String())
+	if message.ReplyTo != "" {
+		replyTo, err := mail.ParseAddress(message.ReplyTo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reply-to address %q", message.ReplyTo)
+		}
+		writeHeader(&buf, "Reply-To", replyTo.String())
+	}
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", message.Subject))
+	writeHeader(&buf, "Date", date.Format(time.RFC1123Z))
+	writeHeader(&buf, "MIME-Version", "1.0")
+
+	if len(message.Attachments) == 0 {
+		writeHeader(&buf, "Content-Type", `text/plain; charset="utf-8"`)
+		writeHeader(&buf, "Content-Transfer-Encoding", "base64")
+		buf.WriteString("\r\n")
+		writeBase64(&buf, []byte(message.Body))
+		return buf.Bytes(), nil
+	}
+
+	boundary, err := newBoundary()
+	if err != nil {
+		return nil, err
+	}
+	writeHeader(&buf, "Content-Type", fmt.Sprintf(`multipart/mixed; boundary="%s"`, boundary))
+	buf.WriteString("\r\n")
+
+	// Text part
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	writeHeader(&buf, "Content-Type", `text/plain; charset="utf-8"`)
+	writeHeader(&buf, "Content-Transfer-Encoding", "base64")
+	buf.WriteString("\r\n")
+	writeBase64(&buf, []byte(message.Body))
+
+	// Attachment parts
+	for _, path := range message.Attachments {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read attachment: %w", err)
+		}
+
+		name := filepath.Base(path)
+		contentType := mime.TypeByExtension(filepath.Ext(name))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		writeHeader(&buf, "Content-Type", contentType)
+		writeHeader(&buf, "Content-Transfer-Encoding", "base64")
+		writeHeader(&buf, "Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+		buf.WriteString("\r\n")
+		writeBase64(&buf, content)
+	}
+
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+// writeHeader writes a single header line
+func writeHeader(buf *bytes.Buffer, key, value string) {
+	fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+}
+
+// writeBase64 writes content base64-encoded in lines of 76 characters
+func writeBase64(buf *bytes.Buffer, content []byte) {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76])
+		buf.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\r\n")
+}
+
+// newBoundary returns a random MIME multipart boundary
+func newBoundary() (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("pm-%x", random), nil
+}
@@ -0,0 +1,235 @@
+package mailer
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testConfig() Config {
+	return Config{
+		Host:        "smtp.example.com",
+		Port:        587,
+		FromAddress: "vermieter@example.com",
+		FromName:    "Max Müller",
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	config := testConfig()
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected valid config, got %v", err)
+	}
+
+	config.Host = ""
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for missing host, got nil")
+	}
+
+	config = testConfig()
+	config.FromAddress = "not an address"
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for invalid sender, got nil")
+	}
+}
+
+func TestBuildMessage_PlainText(t *testing.T) {
+	data, err := BuildMessage(testConfig(), Message{
+		To:      "mieter@example.com",
+		Subject: "Zahlungserinnerung März",
+		Body:    "Hallo,\nbitte überweisen Sie die Miete.",
+	}, time.Date(2024, time.March, 5, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Error building message: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Error parsing built message: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Zahlungserinnerung März" {
+		t.Errorf("Unexpected subject %q (%v)", subject, err)
+	}
+	if !strings.Contains(msg.Header.Get("To"), "mieter@example.com") {
+		t.Errorf("Unexpected recipient %q", msg.Header.Get("To"))
+	}
+
+	// Replies go to the sender unless another address is given
+	if replyTo := msg.Header.Get("Reply-To"); replyTo != "" {
+		t.Errorf("Expected no Reply-To header, got %q", replyTo)
+	}
+	data, err = BuildMessage(testConfig(), Message{
+		To:      "mieter@example.com",
+		ReplyTo: "Verwaltung GmbH <info@verwaltung.example>",
+	}, time.Now())
+	if err != nil {
+		t.Fatalf("Error building message: %v", err)
+	}
+	msg, err = mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Error parsing built message: %v", err)
+	}
+	if replyTo := msg.Header.Get("Reply-To"); !strings.Contains(replyTo, "<info@verwaltung.example>") {
+		t.Errorf("Unexpected Reply-To %q", replyTo)
+	}
+
+	// Invalid recipient
+	if _, err := BuildMessage(testConfig(), Message{To: "nobody"}, time.Now()); err == nil {
+		t.Error("Expected error for invalid recipient, got nil")
+	}
+}
+
+func TestBuildMessage_Attachment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statement.pdf")
+	if err := os.WriteFile(path, []byte("%PDF-1.4 test"), 0644); err != nil {
+		t.Fatalf("Error writing attachment: %v", err)
+	}
+
+	data, err := BuildMessage(testConfig(), Message{
+		To:          "mieter@example.com",
+		Subject:     "Statement",
+		Body:        "Please find attached.",
+		Attachments: []string{path},
+	}, time.Now())
+	if err != nil {
+		t.Fatalf("Error building message: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Error parsing built message: %v", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("Expected multipart/mixed, got %q (%v)", mediaType, err)
+	}
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var filenames []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Error reading part: %v", err)
+		}
+		if part.FileName() != "" {
+			filenames = append(filenames, part.FileName())
+		}
+	}
+
+	if len(filenames) != 1 || filenames[0] != "statement.pdf" {
+		t.Errorf("Expected one attachment statement.pdf, got %v", filenames)
+	}
+
+	// Missing attachment
+	_, err = BuildMessage(testConfig(), Message{To: "mieter@example.com", Attachments: []string{path + ".missing"}}, time.Now())
+	if err == nil {
+		t.Error("Expected error for missing attachment, got nil")
+	}
+}
+
+// serveSMTP accepts a single SMTP session on listener and sends the MAIL FROM
+// and RCPT TO commands it receives on commands
+func serveSMTP(listener net.Listener, commands chan<- string) {
+	defer close(commands)
+
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		io.WriteString(conn, line+"\r\n")
+	}
+
+	reply("220 localhost ready")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"), strings.HasPrefix(command, "RCPT TO:"):
+			commands <- line
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			for {
+				data, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if data == ".\r\n" {
+					break
+				}
+			}
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSend_DisplayNames(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error listening: %v", err)
+	}
+	defer listener.Close()
+
+	commands := make(chan string, 4)
+	go serveSMTP(listener, commands)
+
+	config := testConfig()
+	config.Host = "127.0.0.1"
+	config.Port = listener.Addr().(*net.TCPAddr).Port
+	config.FromAddress = "Hausverwaltung <vermieter@example.com>"
+
+	err = Send(config, Message{
+		To:      "Max Mustermann <max@example.org>",
+		Subject: "Nebenkostenabrechnung",
+		Body:    "Anbei die Abrechnung.",
+	})
+	if err != nil {
+		t.Fatalf("Error sending message: %v", err)
+	}
+
+	// The envelope carries the bare addresses
+	var received []string
+	for command := range commands {
+		received = append(received, command)
+	}
+	want := []string{"MAIL FROM:<vermieter@example.com>", "RCPT TO:<max@example.org>"}
+	if len(received) != len(want) {
+		t.Fatalf("Expected commands %q, got %q", want, received)
+	}
+	for i := range want {
+		if !strings.HasPrefix(received[i], want[i]) {
+			t.Errorf("Expected command %q, got %q", want[i], received[i])
+		}
+	}
+}
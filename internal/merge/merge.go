@@ -0,0 +1,332 @@
+package merge
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"property-management/internal/models"
+	"property-management/internal/repository"
+)
+
+// repositories bundles the repositories of one database
+type repositories struct {
+	houses           *repository.HouseRepository
+	propertyManagers *repository.PropertyManagerRepository
+	meters           *repository.MeterRepository
+	readings         *repository.MeterReadingRepository
+	loanScenarios    *repository.LoanScenarioRepository
+}
+
+// newRepositories binds all merged repositories to the given database or transaction
+func newRepositories(db repository.Querier) *repositories {
+	return &repositories{
+		houses:           repository.NewHouseRepository(db),
+		propertyManagers: repository.NewPropertyManagerRepository(db),
+		meters:           repository.NewMeterRepository(db),
+		readings:         repository.NewMeterReadingRepository(db),
+		loanScenarios:    repository.NewLoanScenarioRepository(db),
+	}
+}
+
+// merger copies records missing from the target database out of the source
+type merger struct {
+	source *repositories
+	target *repositories
+	report *models.MergeReport
+}
+
+// Merge adds everything from source that target does not have yet. Records
+// are matched by natural keys rather than IDs, since both databases number
+// their rows independently:
+//
+//   - houses by address (street, number, zip code, city)
+//   - meters by type and serial number within a house
+//   - meter readings by meter and date
+//   - loan scenarios by name within a house
+//
+// Records present in both with different values are kept as they are in
+// target and listed as conflicts in the report. All changes to target are
+// made in one transaction, so if any record cannot be added nothing is merged.
+func Merge(target, source *sql.DB) (*models.MergeReport, error) {
+	tx, err := target.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	m := &merger{
+		source: newRepositories(source),
+		target: newRepositories(tx),
+		report: &models.MergeReport{Conflicts: []string{}},
+	}
+
+	sourceHouses, err := m.source.houses.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	targetHouses, err := m.target.houses.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	housesByAddress := make(map[string]models.House)
+	for _, house := range targetHouses {
+		housesByAddress[addressKey(&house)] = house
+	}
+
+	for _, sourceHouse := range sourceHouses {
+		if err := m.mergeHouse(sourceHouse, housesByAddress); err != nil {
+			return nil, fmt.Errorf("house %s: %w", sourceHouse.Name, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return m.report, nil
+}
+
+// mergeHouse matches or creates a house and merges everything attached to it
+func (m *merger) mergeHouse(sourceHouse models.House, housesByAddress map[string]models.House) error {
+	targetHouse, ok := housesByAddress[addressKey(&sourceHouse)]
+	if !ok {
+		// Create the house; it is archived only after its records were added
+		house := models.NewHouse(sourceHouse.Name, sourceHouse.Street, sourceHouse.Number,
+			sourceHouse.Country, sourceHouse.ZipCode, sourceHouse.City)
+		if err := m.target.houses.Create(house); err != nil {
+			return err
+		}
+		house.PurchasePrice = sourceHouse.PurchasePrice
+		house.IncidentalCosts = sourceHouse.IncidentalCosts
+		house.LandValueShare = sourceHouse.LandValueShare
+		if err := m.target.houses.UpdateAcquisition(house); err != nil {
+			return err
+		}
+
+		if err := m.mergeHouseRecords(sourceHouse.ID, house.ID, sourceHouse.Name); err != nil {
+			return err
+		}
+		if sourceHouse.SaleDate != nil {
+			if err := m.target.houses.Archive(house.ID, *sourceHouse.SaleDate); err != nil {
+				return err
+			}
+		}
+
+		housesByAddress[addressKey(house)] = *house
+		m.report.HousesAdded++
+		return nil
+	}
+
+	m.report.HousesMatched++
+
+	if sourceHouse.Name != targetHouse.Name {
+		m.conflict("house %s is named %q in the other file", targetHouse.Name, sourceHouse.Name)
+	}
+	if sourceHouse.PurchasePrice != targetHouse.PurchasePrice ||
+		sourceHouse.IncidentalCosts != targetHouse.IncidentalCosts ||
+		sourceHouse.LandValueShare != targetHouse.LandValueShare {
+		m.conflict("house %s has different acquisition figures in the other file", targetHouse.Name)
+	}
+	if sourceHouse.IsArchived() != targetHouse.IsArchived() {
+		m.conflict("house %s is archived in only one of the files", targetHouse.Name)
+	}
+
+	// Sold houses are read-only
+	if targetHouse.IsArchived() {
+		m.conflict("house %s is archived here, so its records from the other file were not merged", targetHouse.Name)
+		return nil
+	}
+
+	return m.mergeHouseRecords(sourceHouse.ID, targetHouse.ID, targetHouse.Name)
+}
+
+// mergeHouseRecords merges the property manager, meters and loan scenarios of a house
+func (m *merger) mergeHouseRecords(sourceHouseID, targetHouseID int64, houseName string) error {
+	if err := m.mergePropertyManager(sourceHouseID, targetHouseID, houseName); err != nil {
+		return err
+	}
+	if err := m.mergeMeters(sourceHouseID, targetHouseID, houseName); err != nil {
+		return err
+	}
+	return m.mergeLoanScenarios(sourceHouseID, targetHouseID, houseName)
+}
+
+// mergePropertyManager adds the source's property manager if the target house has none
+func (m *merger) mergePropertyManager(sourceHouseID, targetHouseID int64, houseName string) error {
+	sourceManager, err := m.source.propertyManagers.GetByHouseID(sourceHouseID)
+	if errors.Is(err, repository.ErrPropertyManagerNotFound) {
+		// The house has no property manager in the other file
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	targetManager, err := m.target.propertyManagers.GetByHouseID(targetHouseID)
+	if err == nil {
+		if !strings.EqualFold(sourceManager.CompanyName, targetManager.CompanyName) {
+			m.conflict("house %s is managed by %s here and by %s in the other file", houseName, targetManager.CompanyName, sourceManager.CompanyName)
+		}
+		return nil
+	}
+	if !errors.Is(err, repository.ErrPropertyManagerNotFound) {
+		return err
+	}
+
+	manager := *sourceManager
+	manager.ID = 0
+	manager.HouseID = targetHouseID
+	if err := m.target.propertyManagers.Save(&manager); err != nil {
+		return err
+	}
+	m.report.PropertyManagersAdded++
+	return nil
+}
+
+// mergeMeters adds missing meters of a house and merges the readings of all its meters
+func (m *merger) mergeMeters(sourceHouseID, targetHouseID int64, houseName string) error {
+	sourceMeters, err := m.source.meters.GetByHouseID(sourceHouseID)
+	if err != nil {
+		return err
+	}
+	targetMeters, err := m.target.meters.GetByHouseID(targetHouseID)
+	if err != nil {
+		return err
+	}
+
+	metersByKey := make(map[string]models.Meter)
+	for _, meter := range targetMeters {
+		metersByKey[meterKey(&meter)] = meter
+	}
+
+	for _, sourceMeter := range sourceMeters {
+		targetMeter, ok := metersByKey[meterKey(&sourceMeter)]
+		if !ok {
+			meter := models.NewMeter(targetHouseID, sourceMeter.Type, sourceMeter.SerialNumber, sourceMeter.Location, sourceMeter.Unit)
+			if err := m.target.meters.Create(meter); err != nil {
+				return err
+			}
+			targetMeter = *meter
+			metersByKey[meterKey(meter)] = targetMeter
+			m.report.MetersAdded++
+		}
+
+		if err := m.mergeReadings(sourceMeter.ID, &targetMeter, houseName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeReadings adds readings for days the target meter has no reading for
+func (m *merger) mergeReadings(sourceMeterID int64, targetMeter *models.Meter, houseName string) error {
+	sourceReadings, err := m.source.readings.GetByMeterID(sourceMeterID)
+	if err != nil {
+		return err
+	}
+	targetReadings, err := m.target.readings.GetByMeterID(targetMeter.ID)
+	if err != nil {
+		return err
+	}
+
+	readingsByDate := make(map[string]models.MeterReading)
+	for _, reading := range targetReadings {
+		readingsByDate[reading.ReadingDate.Format("2006-01-02")] = reading
+	}
+
+	for _, sourceReading := range sourceReadings {
+		date := sourceReading.ReadingDate.Format("2006-01-02")
+		if targetReading, ok := readingsByDate[date]; ok {
+			if math.Abs(targetReading.Value-sourceReading.Value) > 1e-9 {
+				m.conflict("meter %s in house %s reads %g on %s here and %g in the other file",
+					targetMeter.SerialNumber, houseName, targetReading.Value, date, sourceReading.Value)
+			}
+			continue
+		}
+
+		reading := models.NewMeterReading(targetMeter.ID, sourceReading.ReadingDate, sourceReading.Value, sourceReading.Note)
+		if err := m.target.readings.Create(reading); err != nil {
+			return err
+		}
+		readingsByDate[date] = *reading
+		m.report.ReadingsAdded++
+	}
+
+	return nil
+}
+
+// mergeLoanScenarios adds loan scenarios whose name the target house does not use yet
+func (m *merger) mergeLoanScenarios(sourceHouseID, targetHouseID int64, houseName string) error {
+	sourceScenarios, err := m.source.loanScenarios.GetByHouseID(sourceHouseID)
+	if err != nil {
+		return err
+	}
+	targetScenarios, err := m.target.loanScenarios.GetByHouseID(targetHouseID)
+	if err != nil {
+		return err
+	}
+
+	scenariosByName := make(map[string]models.LoanScenario)
+	for _, scenario := range targetScenarios {
+		scenariosByName[normalize(scenario.Name)] = scenario
+	}
+
+	for _, sourceScenario := range sourceScenarios {
+		if targetScenario, ok := scenariosByName[normalize(sourceScenario.Name)]; ok {
+			if !sameLoanTerms(&targetScenario, &sourceScenario) {
+				m.conflict("loan scenario %s of house %s has different terms in the other file", targetScenario.Name, houseName)
+			}
+			continue
+		}
+
+		scenario := sourceScenario
+		scenario.ID = 0
+		scenario.HouseID = targetHouseID
+		if err := m.target.loanScenarios.Create(&scenario); err != nil {
+			return err
+		}
+		scenariosByName[normalize(scenario.Name)] = scenario
+		m.report.LoanScenariosAdded++
+	}
+
+	return nil
+}
+
+// conflict records a difference between the two files
+func (m *merger) conflict(format string, args ...interface{}) {
+	m.report.Conflicts = append(m.report.Conflicts, fmt.Sprintf(format, args...))
+}
+
+// addressKey identifies a house by its address
+func addressKey(house *models.House) string {
+	return strings.Join([]string{
+		normalize(house.Street),
+		normalize(house.Number),
+		normalize(house.ZipCode),
+		normalize(house.City),
+	}, "|")
+}
+
+// meterKey identifies a meter within a house
+func meterKey(meter *models.Meter) string {
+	return string(meter.Type) + "|" + normalize(meter.SerialNumber)
+}
+
+// sameLoanTerms reports whether two scenarios describe the same loan and offer
+func sameLoanTerms(a, b *models.LoanScenario) bool {
+	return a.OutstandingBalance == b.OutstandingBalance &&
+		a.CurrentRate == b.CurrentRate &&
+		a.CurrentRemainingMonths == b.CurrentRemainingMonths &&
+		a.OfferRate == b.OfferRate &&
+		a.OfferTermMonths == b.OfferTermMonths &&
+		a.OfferFees == b.OfferFees
+}
+
+// normalize makes natural keys insensitive to case and surrounding or repeated spaces
+func normalize(value string) string {
+	return strings.ToLower(strings.Join(strings.Fields(value), " "))
+}
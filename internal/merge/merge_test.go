@@ -0,0 +1,197 @@
+package merge
+
+import (
+	"testing"
+	"time"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestMerge(t *testing.T) {
+	target := testutil.NewDB(t)
+	source := testutil.NewDB(t)
+
+	targetRepos := newRepositories(target)
+	sourceRepos := newRepositories(source)
+
+	// The same house on both machines, spelled slightly differently
+	shared := createHouse(t, targetRepos, "Main Street", "Main St.", "1")
+	sourceShared := createHouse(t, sourceRepos, "Main Street 1", " main st. ", "1")
+
+	// A house only the other machine knows, already sold
+	sourceOnly := createHouse(t, sourceRepos, "Garden House", "Garden Way", "7")
+
+	// Meters: one on both machines, one only in the source
+	targetMeter := createMeter(t, targetRepos, shared.ID, "W-1")
+	sourceMeter := createMeter(t, sourceRepos, sourceShared.ID, "w-1")
+	createMeter(t, sourceRepos, sourceShared.ID, "E-9")
+	gardenMeter := createMeter(t, sourceRepos, sourceOnly.ID, "G-3")
+
+	// Readings: one identical, one conflicting, one new
+	createReading(t, targetRepos, targetMeter.ID, testutil.Date(2024, time.January, 1), 100)
+	createReading(t, targetRepos, targetMeter.ID, testutil.Date(2024, time.February, 1), 110)
+	createReading(t, sourceRepos, sourceMeter.ID, testutil.Date(2024, time.January, 1), 100)
+	createReading(t, sourceRepos, sourceMeter.ID, testutil.Date(2024, time.February, 1), 111)
+	createReading(t, sourceRepos, sourceMeter.ID, testutil.Date(2024, time.March, 1), 120)
+	createReading(t, sourceRepos, gardenMeter.ID, testutil.Date(2024, time.March, 1), 5)
+
+	// A property manager only the source has recorded
+	manager := &models.PropertyManager{HouseID: sourceShared.ID, CompanyName: "Verwaltung GmbH"}
+	if err := sourceRepos.propertyManagers.Save(manager); err != nil {
+		t.Fatalf("Error saving property manager: %v", err)
+	}
+
+	if err := sourceRepos.houses.Archive(sourceOnly.ID, testutil.Date(2024, time.June, 30)); err != nil {
+		t.Fatalf("Error archiving house: %v", err)
+	}
+
+	report, err := Merge(target, source)
+	if err != nil {
+		t.Fatalf("Error merging: %v", err)
+	}
+
+	if report.HousesAdded != 1 || report.HousesMatched != 1 {
+		t.Errorf("Expected 1 house added and 1 matched, got %+v", report)
+	}
+	if report.MetersAdded != 2 || report.ReadingsAdded != 2 || report.PropertyManagersAdded != 1 {
+		t.Errorf("Expected 2 meters, 2 readings and 1 property manager added, got %+v", report)
+	}
+
+	// Different name and the conflicting reading are reported
+	if len(report.Conflicts) != 2 {
+		t.Errorf("Expected 2 conflicts, got %v", report.Conflicts)
+	}
+
+	// The conflicting reading keeps this database's value
+	readings, err := targetRepos.readings.GetByMeterID(targetMeter.ID)
+	if err != nil {
+		t.Fatalf("Error getting readings: %v", err)
+	}
+	if len(readings) != 3 {
+		t.Fatalf("Expected 3 readings after merge, got %d", len(readings))
+	}
+	for _, reading := range readings {
+		if reading.ReadingDate.Month() == time.February && reading.Value != 110 {
+			t.Errorf("Expected conflicting reading to keep 110, got %v", reading.Value)
+		}
+	}
+
+	// The new house arrives archived, with its records
+	archived, err := targetRepos.houses.GetArchived()
+	if err != nil {
+		t.Fatalf("Error getting archived houses: %v", err)
+	}
+	if len(archived) != 1 || archived[0].Name != "Garden House" {
+		t.Fatalf("Expected Garden House to be archived, got %+v", archived)
+	}
+	meters, err := targetRepos.meters.GetByHouseID(archived[0].ID)
+	if err != nil {
+		t.Fatalf("Error getting meters: %v", err)
+	}
+	if len(meters) != 1 {
+		t.Errorf("Expected the archived house's meter to be merged, got %d", len(meters))
+	}
+
+	// Merging again adds nothing
+	report, err = Merge(target, source)
+	if err != nil {
+		t.Fatalf("Error merging again: %v", err)
+	}
+	if report.HousesAdded != 0 || report.MetersAdded != 0 || report.ReadingsAdded != 0 || report.PropertyManagersAdded != 0 {
+		t.Errorf("Expected second merge to add nothing, got %+v", report)
+	}
+}
+
+func TestMerge_FailureChangesNothing(t *testing.T) {
+	target := testutil.NewDB(t)
+	source := testutil.NewDB(t)
+
+	targetRepos := newRepositories(target)
+	sourceRepos := newRepositories(source)
+
+	// The first house merges cleanly
+	first := createHouse(t, sourceRepos, "A House", "First Street", "1")
+	meter := createMeter(t, sourceRepos, first.ID, "W-1")
+	createReading(t, sourceRepos, meter.ID, testutil.Date(2024, time.January, 1), 100)
+
+	// The second has a loan scenario that fails validation, written by a
+	// version with looser checks
+	second := createHouse(t, sourceRepos, "B House", "Second Street", "2")
+	_, err := source.Exec(`
+		INSERT INTO loan_scenarios (house_id, name, outstanding_balance, current_rate, current_remaining_months, offer_rate, offer_term_months)
+		VALUES (?, 'Broken', -1, 3, 120, 2, 120)`, second.ID)
+	if err != nil {
+		t.Fatalf("Error inserting invalid loan scenario: %v", err)
+	}
+
+	if _, err := Merge(target, source); err == nil {
+		t.Fatal("Expected error merging an invalid loan scenario, got nil")
+	}
+
+	// Nothing from either house was kept
+	houses, err := targetRepos.houses.GetAll()
+	if err != nil {
+		t.Fatalf("Error getting houses: %v", err)
+	}
+	if len(houses) != 0 {
+		t.Errorf("Expected no houses after the failed merge, got %+v", houses)
+	}
+	var count int
+	if err := target.QueryRow(`SELECT (SELECT COUNT(*) FROM meters) + (SELECT COUNT(*) FROM meter_readings)`).Scan(&count); err != nil {
+		t.Fatalf("Error counting records: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected no meters or readings after the failed merge, got %d", count)
+	}
+
+	// The target is still usable afterwards
+	createHouse(t, targetRepos, "C House", "Third Street", "3")
+}
+
+func TestMerge_ReadFailureChangesNothing(t *testing.T) {
+	target := testutil.NewDB(t)
+	source := testutil.NewDB(t)
+
+	targetRepos := newRepositories(target)
+	createHouse(t, newRepositories(source), "A House", "First Street", "1")
+
+	// Reading the property manager fails for a reason other than its absence
+	if _, err := source.Exec(`ALTER TABLE property_managers RENAME TO property_managers_old`); err != nil {
+		t.Fatalf("Error renaming table: %v", err)
+	}
+
+	if _, err := Merge(target, source); err == nil {
+		t.Fatal("Expected error merging an unreadable property manager, got nil")
+	}
+
+	houses, err := targetRepos.houses.GetAll()
+	if err != nil {
+		t.Fatalf("Error getting houses: %v", err)
+	}
+	if len(houses) != 0 {
+		t.Errorf("Expected no houses after the failed merge, got %+v", houses)
+	}
+}
+
+func createHouse(t *testing.T, repos *repositories, name, street, number string) *models.House {
+	house := models.NewHouse(name, street, number, "Germany", "10115", "Berlin")
+	if err := repos.houses.Create(house); err != nil {
+		t.Fatalf("Error creating house: %v", err)
+	}
+	return house
+}
+
+func createMeter(t *testing.T, repos *repositories, houseID int64, serialNumber string) *models.Meter {
+	meter := models.NewMeter(houseID, models.MeterTypeWater, serialNumber, "", "")
+	if err := repos.meters.Create(meter); err != nil {
+		t.Fatalf("Error creating meter: %v", err)
+	}
+	return meter
+}
+
+func createReading(t *testing.T, repos *repositories, meterID int64, date time.Time, value float64) {
+	if err := repos.readings.Create(models.NewMeterReading(meterID, date, value, "")); err != nil {
+		t.Fatalf("Error creating reading: %v", err)
+	}
+}
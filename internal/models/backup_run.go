@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// BackupRun is the outcome of an automatic or on-demand backup
+type BackupRun struct {
+	ID        int64     `json:"id"`
+	StartedAt time.Time `json:"startedAt"`
+	Scheduled bool      `json:"scheduled"` // taken by the background job rather than on request
+	Path      string    `json:"path"`
+	Size      int64     `json:"size"`
+	Error     string    `json:"error"`
+}
+
+// Succeeded reports whether the backup was written
+func (r *BackupRun) Succeeded() bool {
+	return r.Error == ""
+}
+
+// BackupStatus summarizes the automatic backup schedule
+type BackupStatus struct {
+	Schedule BackupSchedule `json:"schedule"`
+	// LastBackup is the most recent successful backup, if any
+	LastBackup *BackupRun `json:"lastBackup,omitempty"`
+	// NextBackupAt is when the next automatic backup is due; nil if backups are off
+	NextBackupAt *time.Time `json:"nextBackupAt,omitempty"`
+}
+
+// NextBackupDue returns when the next automatic backup should be taken after
+// the last successful one; a missing backup is due right away
+func (s BackupSchedule) NextBackupDue(lastBackup *BackupRun, now time.Time) *time.Time {
+	interval := s.Interval()
+	if interval == 0 {
+		return nil
+	}
+
+	due := now
+	if lastBackup != nil {
+		due = lastBackup.StartedAt.Add(interval)
+	}
+	return &due
+}
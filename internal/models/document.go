@@ -0,0 +1,59 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// DocumentEntityType identifies the kind of record a document is attached to
+type DocumentEntityType string
+
+const (
+	DocumentEntityHouse DocumentEntityType = "house"
+	DocumentEntityMeter DocumentEntityType = "meter"
+)
+
+// IsValid reports whether documents can be attached to this entity type
+func (t DocumentEntityType) IsValid() bool {
+	switch t {
+	case DocumentEntityHouse, DocumentEntityMeter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Document represents a file (lease, correspondence, receipt) attached to an entity
+type Document struct {
+	ID          int64              `json:"id"`
+	EntityType  DocumentEntityType `json:"entityType"`
+	EntityID    int64              `json:"entityId"`
+	FileName    string             `json:"fileName"`
+	StoredPath  string             `json:"storedPath"` // relative to the document vault
+	ContentType string             `json:"contentType"`
+	Size        int64              `json:"size"`
+	Description string             `json:"description"`
+	CreatedAt   time.Time          `json:"createdAt"`
+}
+
+// Validate ensures all document data is valid
+func (d *Document) Validate() error {
+	// Entity validation
+	if !d.EntityType.IsValid() {
+		return errors.New("documents cannot be attached to this kind of record")
+	}
+	if d.EntityID <= 0 {
+		return errors.New("document must be attached to a record")
+	}
+
+	// File validation
+	if strings.TrimSpace(d.FileName) == "" {
+		return errors.New("document file name cannot be empty")
+	}
+	if strings.TrimSpace(d.StoredPath) == "" {
+		return errors.New("document has not been stored")
+	}
+
+	return nil
+}
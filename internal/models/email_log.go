@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// Email delivery outcomes recorded in the send log
+const (
+	EmailStatusSent   = "sent"
+	EmailStatusFailed = "failed"
+)
+
+// EmailLogEntry records a single outgoing email
+type EmailLogEntry struct {
+	ID          int64     `json:"id"`
+	Recipient   string    `json:"recipient"`
+	Subject     string    `json:"subject"`
+	Attachments []string  `json:"attachments"`
+	Status      string    `json:"status"`
+	Error       string    `json:"error"`
+	SentAt      time.Time `json:"sentAt"`
+}
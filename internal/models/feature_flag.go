@@ -0,0 +1,76 @@
+package models
+
+// Feature names a subsystem that can be switched on or off
+type Feature string
+
+const (
+	FeatureDocumentEmail Feature = "document_email"
+)
+
+// FeatureDefinition describes a feature known to this version of the application
+type FeatureDefinition struct {
+	Name        Feature `json:"name"`
+	Description string  `json:"description"`
+	// Experimental features ship dark: they stay off unless experimental
+	// mode is on and the feature has been enabled explicitly
+	Experimental     bool `json:"experimental"`
+	EnabledByDefault bool `json:"enabledByDefault"`
+}
+
+// featureDefinitions lists all features in display order
+var featureDefinitions = []FeatureDefinition{
+	{
+		Name:             FeatureDocumentEmail,
+		Description:      "Send documents as email attachments",
+		EnabledByDefault: true,
+	},
+}
+
+// FeatureDefinitions returns all features known to this version of the application
+func FeatureDefinitions() []FeatureDefinition {
+	return append([]FeatureDefinition(nil), featureDefinitions...)
+}
+
+// LookupFeature returns the definition of the named feature
+func LookupFeature(name Feature) (FeatureDefinition, bool) {
+	for _, definition := range featureDefinitions {
+		if definition.Name == name {
+			return definition, true
+		}
+	}
+	return FeatureDefinition{}, false
+}
+
+// FeatureFlag is the effective state of a feature
+type FeatureFlag struct {
+	FeatureDefinition
+	Enabled bool `json:"enabled"`
+}
+
+// IsEnabled reports whether the feature is on, given the stored overrides
+func (d FeatureDefinition) IsEnabled(overrides map[Feature]bool, experimentalMode bool) bool {
+	enabled, ok := overrides[d.Name]
+
+	// Experimental features need experimental mode and an explicit opt-in
+	if d.Experimental {
+		return experimentalMode && ok && enabled
+	}
+
+	if !ok {
+		return d.EnabledByDefault
+	}
+	return enabled
+}
+
+// ResolveFeatureFlags combines the feature definitions with the stored
+// overrides into the effective state of every feature
+func ResolveFeatureFlags(overrides map[Feature]bool, experimentalMode bool) []FeatureFlag {
+	flags := make([]FeatureFlag, 0, len(featureDefinitions))
+	for _, definition := range featureDefinitions {
+		flags = append(flags, FeatureFlag{
+			FeatureDefinition: definition,
+			Enabled:           definition.IsEnabled(overrides, experimentalMode),
+		})
+	}
+	return flags
+}
@@ -9,15 +9,24 @@ import (
 
 // House represents a property in the system
 type House struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Street    string    `json:"street"`
-	Number    string    `json:"number"`
-	Country   string    `json:"country"`
-	ZipCode   string    `json:"zipCode"`
-	City      string    `json:"city"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID              int64      `json:"id"`
+	Name            string     `json:"name"`
+	Street          string     `json:"street"`
+	Number          string     `json:"number"`
+	Country         string     `json:"country"`
+	ZipCode         string     `json:"zipCode"`
+	City            string     `json:"city"`
+	SaleDate        *time.Time `json:"saleDate,omitempty"`
+	PurchasePrice   float64    `json:"purchasePrice"`
+	IncidentalCosts float64    `json:"incidentalCosts"`
+	LandValueShare  float64    `json:"landValueShare"` // percent of the total investment
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// IsArchived reports whether the house has been sold and is therefore read-only
+func (h *House) IsArchived() bool {
+	return h.SaleDate != nil
 }
 
 // Validate ensures all house data is valid
@@ -62,6 +71,26 @@ func (h *House) Validate() error {
 	return nil
 }
 
+// ValidateAcquisition ensures the acquisition data of the house is valid
+func (h *House) ValidateAcquisition() error {
+	// Amount validation
+	if h.PurchasePrice < 0 || h.IncidentalCosts < 0 {
+		return errors.New("purchase price and incidental costs cannot be negative")
+	}
+
+	// Land value share validation (percent of the total investment)
+	if h.LandValueShare < 0 || h.LandValueShare > 100 {
+		return errors.New("land value share must be between 0 and 100 percent")
+	}
+
+	return nil
+}
+
+// TotalInvestment returns the purchase price including incidental costs
+func (h *House) TotalInvestment() float64 {
+	return h.PurchasePrice + h.IncidentalCosts
+}
+
 // NewHouse creates a new house with the given details
 func NewHouse(name, street, number, country, zipCode, city string) *House {
 	now := time.Now()
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// IntegrityCheck is the outcome of verifying the live database and its newest backup
+type IntegrityCheck struct {
+	ID         int64     `json:"id"`
+	CheckedAt  time.Time `json:"checkedAt"`
+	DatabaseOK bool      `json:"databaseOk"`
+	BackupPath string    `json:"backupPath"`
+	BackupOK   bool      `json:"backupOk"`
+	// BackupSkipped is set when there was no backup to verify, either because
+	// automatic backups are off or because none has been taken yet
+	BackupSkipped bool   `json:"backupSkipped"`
+	Error         string `json:"error"`
+}
+
+// Passed reports whether the database was found intact, and the backup too
+// unless there was none to verify
+func (c *IntegrityCheck) Passed() bool {
+	return c.DatabaseOK && (c.BackupOK || c.BackupSkipped)
+}
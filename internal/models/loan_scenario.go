@@ -0,0 +1,132 @@
+package models
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// LoanScenario compares the current financing of a house against a
+// hypothetical refinancing offer. Rates are nominal annual percentages.
+type LoanScenario struct {
+	ID                     int64     `json:"id"`
+	HouseID                int64     `json:"houseId"`
+	Name                   string    `json:"name"`
+	OutstandingBalance     float64   `json:"outstandingBalance"`
+	CurrentRate            float64   `json:"currentRate"`
+	CurrentRemainingMonths int       `json:"currentRemainingMonths"`
+	OfferRate              float64   `json:"offerRate"`
+	OfferTermMonths        int       `json:"offerTermMonths"`
+	OfferFees              float64   `json:"offerFees"`
+	CreatedAt              time.Time `json:"createdAt"`
+	UpdatedAt              time.Time `json:"updatedAt"`
+}
+
+// LoanComparison is the outcome of comparing a loan scenario's two options
+type LoanComparison struct {
+	CurrentMonthlyPayment float64 `json:"currentMonthlyPayment"`
+	CurrentTotalInterest  float64 `json:"currentTotalInterest"`
+	OfferMonthlyPayment   float64 `json:"offerMonthlyPayment"`
+	OfferTotalInterest    float64 `json:"offerTotalInterest"`
+	MonthlySavings        float64 `json:"monthlySavings"`
+	TotalSavings          float64 `json:"totalSavings"`
+	// BreakEvenMonths is the number of months until the monthly savings have
+	// paid off the offer's fees, or -1 if the offer does not break even while
+	// both loans are still being repaid
+	BreakEvenMonths int `json:"breakEvenMonths"`
+}
+
+// Validate ensures all loan scenario data is valid
+func (s *LoanScenario) Validate() error {
+	// House validation
+	if s.HouseID <= 0 {
+		return errors.New("loan scenario must belong to a house")
+	}
+
+	// Name validation
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("scenario name cannot be empty")
+	}
+
+	return s.ValidateTerms()
+}
+
+// ValidateTerms ensures the loan figures can be compared, independent of
+// whether the scenario is stored
+func (s *LoanScenario) ValidateTerms() error {
+	// Balance validation
+	if s.OutstandingBalance <= 0 {
+		return errors.New("outstanding balance must be greater than zero")
+	}
+
+	// Rate validation
+	if s.CurrentRate < 0 || s.OfferRate < 0 {
+		return errors.New("interest rates cannot be negative")
+	}
+
+	// Term validation
+	if s.CurrentRemainingMonths <= 0 || s.OfferTermMonths <= 0 {
+		return errors.New("loan terms must be at least one month")
+	}
+
+	// Fees validation
+	if s.OfferFees < 0 {
+		return errors.New("fees cannot be negative")
+	}
+
+	return nil
+}
+
+// Compare calculates payments, interest, and the break-even point of refinancing
+func (s *LoanScenario) Compare() LoanComparison {
+	currentPayment := AnnuityPayment(s.OutstandingBalance, s.CurrentRate, s.CurrentRemainingMonths)
+	offerPayment := AnnuityPayment(s.OutstandingBalance, s.OfferRate, s.OfferTermMonths)
+
+	currentTotal := currentPayment * float64(s.CurrentRemainingMonths)
+	offerTotal := offerPayment*float64(s.OfferTermMonths) + s.OfferFees
+
+	comparison := LoanComparison{
+		CurrentMonthlyPayment: roundCents(currentPayment),
+		CurrentTotalInterest:  roundCents(currentTotal - s.OutstandingBalance),
+		OfferMonthlyPayment:   roundCents(offerPayment),
+		OfferTotalInterest:    roundCents(offerTotal - s.OfferFees - s.OutstandingBalance),
+		MonthlySavings:        roundCents(currentPayment - offerPayment),
+		TotalSavings:          roundCents(currentTotal - offerTotal),
+		BreakEvenMonths:       -1,
+	}
+
+	monthlySavings := currentPayment - offerPayment
+	switch {
+	case s.OfferFees == 0 && monthlySavings >= 0:
+		comparison.BreakEvenMonths = 0
+	case monthlySavings > 0:
+		// Savings only accrue while both loans would still be paid
+		months := int(math.Ceil(s.OfferFees / monthlySavings))
+		if months <= min(s.CurrentRemainingMonths, s.OfferTermMonths) {
+			comparison.BreakEvenMonths = months
+		}
+	}
+
+	return comparison
+}
+
+// AnnuityPayment returns the constant monthly payment that repays principal
+// at the given nominal annual rate (in percent) over the given number of months
+func AnnuityPayment(principal, annualRate float64, months int) float64 {
+	if months <= 0 {
+		return 0
+	}
+
+	monthlyRate := annualRate / 100 / 12
+	if monthlyRate == 0 {
+		return principal / float64(months)
+	}
+
+	return principal * monthlyRate / (1 - math.Pow(1+monthlyRate, -float64(months)))
+}
+
+// roundCents rounds an amount to whole cents
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,68 @@
+package models
+
+import "testing"
+
+func TestLoanScenario_Compare(t *testing.T) {
+	scenario := LoanScenario{
+		Name:                   "Bank offer",
+		OutstandingBalance:     100000,
+		CurrentRate:            6,
+		CurrentRemainingMonths: 360,
+		OfferRate:              5,
+		OfferTermMonths:        360,
+		OfferFees:              2000,
+	}
+
+	comparison := scenario.Compare()
+
+	// 100,000 over 30 years: 599.55 at 6%, 536.82 at 5%
+	if comparison.CurrentMonthlyPayment != 599.55 {
+		t.Errorf("Expected current payment 599.55, got %v", comparison.CurrentMonthlyPayment)
+	}
+	if comparison.OfferMonthlyPayment != 536.82 {
+		t.Errorf("Expected offer payment 536.82, got %v", comparison.OfferMonthlyPayment)
+	}
+	if comparison.MonthlySavings != 62.73 {
+		t.Errorf("Expected monthly savings 62.73, got %v", comparison.MonthlySavings)
+	}
+
+	// 2,000 in fees are recovered after 32 months of savings
+	if comparison.BreakEvenMonths != 32 {
+		t.Errorf("Expected break-even after 32 months, got %d", comparison.BreakEvenMonths)
+	}
+
+	// A more expensive offer never breaks even
+	scenario.OfferRate = 7
+	if comparison := scenario.Compare(); comparison.BreakEvenMonths != -1 || comparison.TotalSavings >= 0 {
+		t.Errorf("Expected no break-even for a worse offer, got %+v", comparison)
+	}
+
+	// Interest-free loans are repaid linearly
+	if payment := AnnuityPayment(1200, 0, 12); payment != 100 {
+		t.Errorf("Expected interest-free payment 100, got %v", payment)
+	}
+}
+
+func TestLoanScenario_CompareDifferentTerms(t *testing.T) {
+	// The current loan ends after 5 years, the offer stretches it to 10
+	scenario := LoanScenario{
+		Name:                   "Longer term",
+		OutstandingBalance:     100000,
+		CurrentRate:            6,
+		CurrentRemainingMonths: 60,
+		OfferRate:              5,
+		OfferTermMonths:        120,
+		OfferFees:              40000,
+	}
+
+	// 40,000 in fees are recovered after 46 months of 872.63 in savings
+	if comparison := scenario.Compare(); comparison.BreakEvenMonths != 46 {
+		t.Errorf("Expected break-even after 46 months, got %d", comparison.BreakEvenMonths)
+	}
+
+	// 60,000 would take 69 months, but the current loan is paid off after 60
+	scenario.OfferFees = 60000
+	if comparison := scenario.Compare(); comparison.BreakEvenMonths != -1 {
+		t.Errorf("Expected no break-even after the current loan ends, got %d", comparison.BreakEvenMonths)
+	}
+}
@@ -0,0 +1,14 @@
+package models
+
+// MergeReport summarizes what merging another database file changed
+type MergeReport struct {
+	HousesAdded           int `json:"housesAdded"`
+	HousesMatched         int `json:"housesMatched"`
+	PropertyManagersAdded int `json:"propertyManagersAdded"`
+	MetersAdded           int `json:"metersAdded"`
+	ReadingsAdded         int `json:"readingsAdded"`
+	LoanScenariosAdded    int `json:"loanScenariosAdded"`
+	// Conflicts describes records that exist in both files with different
+	// values; the values already in this database were kept
+	Conflicts []string `json:"conflicts"`
+}
@@ -0,0 +1,153 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// MeterType identifies what a meter measures
+type MeterType string
+
+const (
+	MeterTypeWater       MeterType = "water"
+	MeterTypeHeat        MeterType = "heat"
+	MeterTypeElectricity MeterType = "electricity"
+)
+
+// DefaultUnit returns the unit readings of this meter type are usually taken in
+func (t MeterType) DefaultUnit() string {
+	switch t {
+	case MeterTypeWater:
+		return "m³"
+	case MeterTypeHeat, MeterTypeElectricity:
+		return "kWh"
+	default:
+		return ""
+	}
+}
+
+// IsValid reports whether the meter type is one of the supported types
+func (t MeterType) IsValid() bool {
+	return t.DefaultUnit() != ""
+}
+
+// Meter represents a water, heat, or electricity meter installed in a house
+type Meter struct {
+	ID           int64     `json:"id"`
+	HouseID      int64     `json:"houseId"`
+	Type         MeterType `json:"type"`
+	SerialNumber string    `json:"serialNumber"`
+	Location     string    `json:"location"`
+	Unit         string    `json:"unit"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// Validate ensures all meter data is valid
+func (m *Meter) Validate() error {
+	// House validation
+	if m.HouseID <= 0 {
+		return errors.New("meter must belong to a house")
+	}
+
+	// Type validation
+	if !m.Type.IsValid() {
+		return errors.New("meter type must be water, heat, or electricity")
+	}
+
+	// Serial number validation
+	if strings.TrimSpace(m.SerialNumber) == "" {
+		return errors.New("meter serial number cannot be empty")
+	}
+
+	// Unit validation
+	if strings.TrimSpace(m.Unit) == "" {
+		return errors.New("meter unit cannot be empty")
+	}
+
+	return nil
+}
+
+// NewMeter creates a new meter, using the type's default unit if none is given
+func NewMeter(houseID int64, meterType MeterType, serialNumber, location, unit string) *Meter {
+	if strings.TrimSpace(unit) == "" {
+		unit = meterType.DefaultUnit()
+	}
+
+	now := time.Now()
+	return &Meter{
+		HouseID:      houseID,
+		Type:         meterType,
+		SerialNumber: serialNumber,
+		Location:     location,
+		Unit:         unit,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// MeterReading represents the value read from a meter on a given date
+type MeterReading struct {
+	ID          int64     `json:"id"`
+	MeterID     int64     `json:"meterId"`
+	ReadingDate time.Time `json:"readingDate"`
+	Value       float64   `json:"value"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Validate ensures all meter reading data is valid
+func (r *MeterReading) Validate() error {
+	// Meter validation
+	if r.MeterID <= 0 {
+		return errors.New("reading must belong to a meter")
+	}
+
+	// Date validation
+	if r.ReadingDate.IsZero() {
+		return errors.New("reading date cannot be empty")
+	}
+
+	// Value validation
+	if r.Value < 0 {
+		return errors.New("reading value cannot be negative")
+	}
+
+	return nil
+}
+
+// NewMeterReading creates a new reading for the given meter
+func NewMeterReading(meterID int64, readingDate time.Time, value float64, note string) *MeterReading {
+	now := time.Now()
+	return &MeterReading{
+		MeterID:     meterID,
+		ReadingDate: readingDate,
+		Value:       value,
+		Note:        note,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// ConsumptionDelta is the consumption measured between two consecutive readings
+type ConsumptionDelta struct {
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	Consumption float64   `json:"consumption"`
+}
+
+// ConsumptionDeltas returns the consumption between each pair of consecutive
+// readings. Readings must be ordered by date.
+func ConsumptionDeltas(readings []MeterReading) []ConsumptionDelta {
+	var deltas []ConsumptionDelta
+	for i := 1; i < len(readings); i++ {
+		deltas = append(deltas, ConsumptionDelta{
+			From:        readings[i-1].ReadingDate,
+			To:          readings[i].ReadingDate,
+			Consumption: readings[i].Value - readings[i-1].Value,
+		})
+	}
+	return deltas
+}
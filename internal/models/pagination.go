@@ -0,0 +1,34 @@
+package models
+
+import "errors"
+
+// MaxPageSize caps how many rows a single page may contain
+const MaxPageSize = 500
+
+// PageRequest selects one page of a sorted listing
+type PageRequest struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	// SortBy names the field to sort by; empty means the listing's default order
+	SortBy     string `json:"sortBy"`
+	Descending bool   `json:"descending"`
+}
+
+// Validate ensures the page bounds are usable
+func (p *PageRequest) Validate() error {
+	// Bounds validation
+	if p.Limit <= 0 || p.Limit > MaxPageSize {
+		return errors.New("page size must be between 1 and 500")
+	}
+	if p.Offset < 0 {
+		return errors.New("page offset cannot be negative")
+	}
+
+	return nil
+}
+
+// HousePage is one page of houses together with the total number of matches
+type HousePage struct {
+	Houses []House `json:"houses"`
+	Total  int     `json:"total"`
+}
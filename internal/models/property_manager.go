@@ -0,0 +1,105 @@
+package models
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// PropertyManager represents an external management company (Hausverwaltung)
+// mandated to manage a house on behalf of the owner
+type PropertyManager struct {
+	ID                   int64      `json:"id"`
+	HouseID              int64      `json:"houseId"`
+	CompanyName          string     `json:"companyName"`
+	ContactName          string     `json:"contactName"`
+	Street               string     `json:"street"`
+	Number               string     `json:"number"`
+	Country              string     `json:"country"`
+	ZipCode              string     `json:"zipCode"`
+	City                 string     `json:"city"`
+	Phone                string     `json:"phone"`
+	Email                string     `json:"email"`
+	MandateStart         *time.Time `json:"mandateStart,omitempty"`
+	MandateEnd           *time.Time `json:"mandateEnd,omitempty"`
+	UseForCorrespondence bool       `json:"useForCorrespondence"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	UpdatedAt            time.Time  `json:"updatedAt"`
+}
+
+// Validate ensures all property manager data is valid
+func (m *PropertyManager) Validate() error {
+	// House validation
+	if m.HouseID <= 0 {
+		return errors.New("property manager must belong to a house")
+	}
+
+	// Company name validation
+	if strings.TrimSpace(m.CompanyName) == "" {
+		return errors.New("company name cannot be empty")
+	}
+
+	// Email validation - optional, but must be well-formed when given
+	if email := strings.TrimSpace(m.Email); email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return errors.New("email address is invalid")
+		}
+	}
+
+	// Mandate period validation
+	if m.MandateStart != nil && m.MandateEnd != nil && m.MandateEnd.Before(*m.MandateStart) {
+		return errors.New("mandate end cannot be before mandate start")
+	}
+
+	return nil
+}
+
+// IsMandateActive reports whether the mandate covers the calendar day of the
+// given time; the first and last day of the mandate are both included.
+// Open-ended periods are treated as unbounded on that side.
+func (m *PropertyManager) IsMandateActive(date time.Time) bool {
+	day := calendarDay(date)
+	if m.MandateStart != nil && day.Before(calendarDay(*m.MandateStart)) {
+		return false
+	}
+	if m.MandateEnd != nil && day.After(calendarDay(*m.MandateEnd)) {
+		return false
+	}
+	return true
+}
+
+// RoutesCorrespondence reports whether generated correspondence dated on the
+// given day should use the manager's sender and footer data instead of the owner's
+func (m *PropertyManager) RoutesCorrespondence(date time.Time) bool {
+	return m.UseForCorrespondence && m.IsMandateActive(date)
+}
+
+// CorrespondenceFooter returns the manager's contact details as the footer
+// of correspondence sent on its behalf, one item per line
+func (m *PropertyManager) CorrespondenceFooter() string {
+	lines := []string{
+		m.CompanyName,
+		m.ContactName,
+		strings.TrimSpace(m.Street + " " + m.Number),
+		strings.TrimSpace(m.ZipCode + " " + m.City),
+		m.Country,
+		m.Phone,
+		m.Email,
+	}
+
+	var footer []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			footer = append(footer, line)
+		}
+	}
+	return strings.Join(footer, "\n")
+}
+
+// calendarDay returns midnight UTC of the day the given time falls on in its
+// own location, so dates stored at midnight compare by day
+func calendarDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPropertyManager_IsMandateActive(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC)
+	manager := PropertyManager{MandateStart: &start, MandateEnd: &end}
+
+	// Any time on the first and the last day is covered
+	covered := []time.Time{
+		start,
+		time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.June, 30, 17, 45, 0, 0, time.UTC),
+		time.Date(2024, time.June, 30, 23, 59, 59, 0, time.FixedZone("CEST", 2*60*60)),
+	}
+	for _, date := range covered {
+		if !manager.IsMandateActive(date) {
+			t.Errorf("Expected mandate to be active at %v", date)
+		}
+	}
+
+	notCovered := []time.Time{
+		time.Date(2023, time.December, 31, 23, 59, 0, 0, time.UTC),
+		time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, date := range notCovered {
+		if manager.IsMandateActive(date) {
+			t.Errorf("Expected mandate to be inactive at %v", date)
+		}
+	}
+
+	// Open-ended mandates have no bound on that side
+	manager.MandateEnd = nil
+	if !manager.IsMandateActive(time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Error("Expected open-ended mandate to be active")
+	}
+}
+
+func TestPropertyManager_CorrespondenceFooter(t *testing.T) {
+	manager := PropertyManager{
+		CompanyName: "Verwaltung GmbH",
+		Street:      "Hauptstraße",
+		Number:      "5",
+		ZipCode:     "10115",
+		City:        "Berlin",
+		Email:       "info@verwaltung.example",
+	}
+
+	want := "Verwaltung GmbH\nHauptstraße 5\n10115 Berlin\ninfo@verwaltung.example"
+	if footer := manager.CorrespondenceFooter(); footer != want {
+		t.Errorf("Expected footer %q, got %q", want, footer)
+	}
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// BackupSchedule is how often automatic backups are taken
+type BackupSchedule string
+
+const (
+	BackupScheduleOff    BackupSchedule = "off"
+	BackupScheduleDaily  BackupSchedule = "daily"
+	BackupScheduleWeekly BackupSchedule = "weekly"
+)
+
+// IsValid reports whether the schedule is known
+func (s BackupSchedule) IsValid() bool {
+	switch s {
+	case BackupScheduleOff, BackupScheduleDaily, BackupScheduleWeekly:
+		return true
+	default:
+		return false
+	}
+}
+
+// Interval returns the time between automatic backups, or zero if they are off
+func (s BackupSchedule) Interval() time.Duration {
+	switch s {
+	case BackupScheduleDaily:
+		return 24 * time.Hour
+	case BackupScheduleWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Settings holds the application-wide user preferences
+type Settings struct {
+	// MarginalTaxRate is the owner's personal marginal income tax rate in percent
+	MarginalTaxRate float64 `json:"marginalTaxRate"`
+	// ShowPostTaxFigures enables after-tax columns next to gross figures in reports
+	ShowPostTaxFigures bool `json:"showPostTaxFigures"`
+
+	// Outgoing mail server used for reminders and documents
+	SMTPHost        string `json:"smtpHost"`
+	SMTPPort        int    `json:"smtpPort"`
+	SMTPUsername    string `json:"smtpUsername"`
+	SMTPPassword    string `json:"smtpPassword"`
+	SMTPFromAddress string `json:"smtpFromAddress"`
+	SMTPFromName    string `json:"smtpFromName"`
+
+	// BackupDirectory is where backups are kept; empty means the default location
+	BackupDirectory string `json:"backupDirectory"`
+	// BackupSchedule controls how often automatic backups are taken
+	BackupSchedule BackupSchedule `json:"backupSchedule"`
+	// BackupRetention is how many automatic backups are kept before the oldest is removed
+	BackupRetention int `json:"backupRetention"`
+
+	// ExperimentalMode allows experimental features to be switched on
+	ExperimentalMode bool `json:"experimentalMode"`
+}
+
+// DefaultSettings returns the settings used before the user changes anything
+func DefaultSettings() *Settings {
+	return &Settings{
+		MarginalTaxRate:    0,
+		ShowPostTaxFigures: false,
+		SMTPPort:           587,
+		BackupSchedule:     BackupScheduleDaily,
+		BackupRetention:    7,
+	}
+}
+
+// Validate ensures all settings are within their allowed ranges
+func (s *Settings) Validate() error {
+	// Tax rate validation
+	if s.MarginalTaxRate < 0 || s.MarginalTaxRate > 100 {
+		return errors.New("marginal tax rate must be between 0 and 100 percent")
+	}
+
+	// Backup validation
+	if !s.BackupSchedule.IsValid() {
+		return errors.New("invalid backup schedule: must be off, daily or weekly")
+	}
+	if s.BackupRetention < 1 || s.BackupRetention > 365 {
+		return errors.New("number of kept backups must be between 1 and 365")
+	}
+
+	// SMTP validation - only checked once a server is configured
+	if strings.TrimSpace(s.SMTPHost) != "" {
+		if s.SMTPPort <= 0 || s.SMTPPort > 65535 {
+			return errors.New("SMTP port must be between 1 and 65535")
+		}
+		if _, err := mail.ParseAddress(s.SMTPFromAddress); err != nil {
+			return errors.New("sender email address is invalid")
+		}
+	}
+
+	return nil
+}
+
+// AfterTax returns a pre-tax amount reduced by the marginal tax rate, in whole cents.
+// Negative amounts (losses) shrink accordingly, reflecting the tax they save.
+func (s *Settings) AfterTax(amount float64) float64 {
+	return roundCents(amount - amount*s.MarginalTaxRate/100)
+}
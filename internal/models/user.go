@@ -0,0 +1,93 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Role determines what a user is allowed to do
+type Role string
+
+const (
+	// RoleAdmin may do everything, including managing users and settings
+	RoleAdmin Role = "admin"
+	// RoleBookkeeper may view everything and edit financial records
+	RoleBookkeeper Role = "bookkeeper"
+	// RoleViewer may only view data, for example a tax advisor
+	RoleViewer Role = "viewer"
+)
+
+// Permission is an action guarded by the App layer
+type Permission string
+
+const (
+	PermissionView           Permission = "view"
+	PermissionEditFinances   Permission = "edit_finances"
+	PermissionEditProperties Permission = "edit_properties"
+	PermissionAdminister     Permission = "administer"
+)
+
+// rolePermissions lists the permissions granted to each role
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin:      {PermissionView, PermissionEditFinances, PermissionEditProperties, PermissionAdminister},
+	RoleBookkeeper: {PermissionView, PermissionEditFinances},
+	RoleViewer:     {PermissionView},
+}
+
+// IsValid reports whether the role is known
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Can reports whether the role grants the permission
+func (r Role) Can(permission Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// User is an account that can log in to the application
+type User struct {
+	ID           int64     `json:"id"`
+	Username     string    `json:"username"`
+	Role         Role      `json:"role"`
+	PasswordHash string    `json:"-"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// Validate ensures all user data is valid
+func (u *User) Validate() error {
+	// Username validation
+	if strings.TrimSpace(u.Username) == "" {
+		return errors.New("username cannot be empty")
+	}
+
+	// Role validation
+	if !u.Role.IsValid() {
+		return errors.New("invalid role: must be admin, bookkeeper or viewer")
+	}
+
+	// Password validation
+	if u.PasswordHash == "" {
+		return errors.New("user must have a password")
+	}
+
+	return nil
+}
+
+// NewUser creates a new user with the given name and role
+func NewUser(username string, role Role) *User {
+	now := time.Now()
+	return &User{
+		Username:  strings.TrimSpace(username),
+		Role:      role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
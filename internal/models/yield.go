@@ -0,0 +1,40 @@
+package models
+
+import "errors"
+
+// YieldInputs holds the annual figures yield metrics are computed from
+type YieldInputs struct {
+	AnnualRent           float64 `json:"annualRent"`
+	AnnualOperatingCosts float64 `json:"annualOperatingCosts"` // non-recoverable costs borne by the owner
+	AnnualDebtService    float64 `json:"annualDebtService"`
+	Equity               float64 `json:"equity"`
+}
+
+// YieldMetrics are the standard return figures of a house, in percent
+type YieldMetrics struct {
+	TotalInvestment   float64 `json:"totalInvestment"`
+	GrossInitialYield float64 `json:"grossInitialYield"`
+	NetInitialYield   float64 `json:"netInitialYield"`
+	CashOnCashReturn  float64 `json:"cashOnCashReturn"`
+}
+
+// CalculateYieldMetrics computes gross/net initial yield and cash-on-cash return.
+// Cash-on-cash return is left at zero when no equity is given.
+func CalculateYieldMetrics(house *House, inputs YieldInputs) (*YieldMetrics, error) {
+	if house.PurchasePrice <= 0 {
+		return nil, errors.New("purchase price is required to calculate yields")
+	}
+
+	netIncome := inputs.AnnualRent - inputs.AnnualOperatingCosts
+	metrics := &YieldMetrics{
+		TotalInvestment:   roundCents(house.TotalInvestment()),
+		GrossInitialYield: roundCents(inputs.AnnualRent / house.PurchasePrice * 100),
+		NetInitialYield:   roundCents(netIncome / house.TotalInvestment() * 100),
+	}
+
+	if inputs.Equity > 0 {
+		metrics.CashOnCashReturn = roundCents((netIncome - inputs.AnnualDebtService) / inputs.Equity * 100)
+	}
+
+	return metrics, nil
+}
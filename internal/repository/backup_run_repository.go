@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// BackupRunRepository handles all database interactions for the backup history
+type BackupRunRepository struct {
+	db Querier
+}
+
+// NewBackupRunRepository creates a new backup run repository
+func NewBackupRunRepository(db Querier) *BackupRunRepository {
+	return &BackupRunRepository{db: db}
+}
+
+// Create records the outcome of a backup
+func (r *BackupRunRepository) Create(run *models.BackupRun) error {
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO backup_runs (started_at, scheduled, path, size, error)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	// Execute the query
+	if run.StartedAt.IsZero() {
+		run.StartedAt = time.Now()
+	}
+	result, err := r.db.Exec(query, run.StartedAt, run.Scheduled, run.Path, run.Size, run.Error)
+	if err != nil {
+		return err
+	}
+
+	// Get the inserted ID and update the run object
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	run.ID = id
+
+	return nil
+}
+
+// GetAll returns the backup history, most recent first
+func (r *BackupRunRepository) GetAll() ([]models.BackupRun, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, started_at, scheduled, path, size, error
+		FROM backup_runs
+		ORDER BY started_at DESC, id DESC
+	`
+
+	// Execute the query
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var runs []models.BackupRun
+	for rows.Next() {
+		run, err := scanBackupRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, *run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// GetLatestSuccessful returns the most recent backup that was written
+func (r *BackupRunRepository) GetLatestSuccessful() (*models.BackupRun, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, started_at, scheduled, path, size, error
+		FROM backup_runs
+		WHERE error = ''
+		ORDER BY started_at DESC, id DESC
+		LIMIT 1
+	`
+
+	// Execute the query
+	run, err := scanBackupRun(r.db.QueryRow(query))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("no backup has been taken yet")
+		}
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// scanBackupRun reads a single backup run from the given row
+func scanBackupRun(row rowScanner) (*models.BackupRun, error) {
+	var run models.BackupRun
+	var startedAt string
+
+	err := row.Scan(
+		&run.ID,
+		&startedAt,
+		&run.Scheduled,
+		&run.Path,
+		&run.Size,
+		&run.Error,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse timestamps
+	run.StartedAt, _ = time.Parse(time.RFC3339, startedAt)
+
+	return &run, nil
+}
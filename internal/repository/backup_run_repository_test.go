@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestBackupRunRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewBackupRunRepository(db)
+
+	// No backups yet
+	if _, err := repo.GetLatestSuccessful(); err == nil {
+		t.Error("Expected error before any backup, got nil")
+	}
+
+	// Record a successful and a newer failed backup
+	succeeded := &models.BackupRun{
+		StartedAt: time.Now().Add(-24 * time.Hour),
+		Scheduled: true,
+		Path:      "/backups/auto_20240101-000000.db",
+		Size:      4096,
+	}
+	failed := &models.BackupRun{
+		Scheduled: true,
+		Error:     "failed to create backup directory",
+	}
+	for _, run := range []*models.BackupRun{succeeded, failed} {
+		if err := repo.Create(run); err != nil {
+			t.Fatalf("Error recording backup run: %v", err)
+		}
+	}
+
+	latest, err := repo.GetLatestSuccessful()
+	if err != nil {
+		t.Fatalf("Error getting latest backup: %v", err)
+	}
+	if latest.ID != succeeded.ID || latest.Size != 4096 || !latest.Succeeded() {
+		t.Errorf("Unexpected latest backup: %+v", latest)
+	}
+
+	runs, err := repo.GetAll()
+	if err != nil {
+		t.Fatalf("Error getting backup runs: %v", err)
+	}
+	if len(runs) != 2 || runs[0].ID != failed.ID || runs[0].Succeeded() {
+		t.Errorf("Unexpected backup history: %+v", runs)
+	}
+
+	// Test next due time
+	now := time.Now()
+	if due := models.BackupScheduleOff.NextBackupDue(latest, now); due != nil {
+		t.Errorf("Expected no due time when backups are off, got %v", due)
+	}
+	if due := models.BackupScheduleDaily.NextBackupDue(nil, now); due == nil || !due.Equal(now) {
+		t.Errorf("Expected first backup to be due now, got %v", due)
+	}
+	if due := models.BackupScheduleWeekly.NextBackupDue(latest, now); due == nil || !due.Equal(latest.StartedAt.Add(7*24*time.Hour)) {
+		t.Errorf("Expected next backup a week after the last, got %v", due)
+	}
+}
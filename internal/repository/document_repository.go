@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// documentOwnerQueries look up the house owning a record documents can be attached to
+var documentOwnerQueries = map[models.DocumentEntityType]string{
+	models.DocumentEntityHouse: `SELECT id FROM houses WHERE id = ?`,
+	models.DocumentEntityMeter: `SELECT house_id FROM meters WHERE id = ?`,
+}
+
+// DocumentRepository handles all database interactions for document metadata
+type DocumentRepository struct {
+	db Querier
+}
+
+// NewDocumentRepository creates a new document repository
+func NewDocumentRepository(db Querier) *DocumentRepository {
+	return &DocumentRepository{db: db}
+}
+
+// Create adds a new document to the database
+func (r *DocumentRepository) Create(document *models.Document) error {
+	// Validate document data
+	if err := document.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure the record the document is attached to exists and its house has not been sold
+	houseID, err := r.owningHouseID(document.EntityType, document.EntityID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New(string(document.EntityType) + " not found")
+		}
+		return err
+	}
+	if err := ensureHouseWritable(r.db, houseID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO documents (entity_type, entity_id, file_name, stored_path, content_type, size, description, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// Execute the query
+	now := time.Now()
+	result, err := r.db.Exec(
+		query,
+		document.EntityType,
+		document.EntityID,
+		document.FileName,
+		document.StoredPath,
+		document.ContentType,
+		document.Size,
+		document.Description,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Get the inserted ID and update the document object
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	document.ID = id
+	document.CreatedAt = now
+
+	return nil
+}
+
+// GetByEntity returns all documents attached to the specified record, newest first
+func (r *DocumentRepository) GetByEntity(entityType models.DocumentEntityType, entityID int64) ([]models.Document, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, entity_type, entity_id, file_name, stored_path, content_type, size, description, created_at
+		FROM documents
+		WHERE entity_type = ? AND entity_id = ?
+		ORDER BY created_at DESC, id DESC
+	`
+
+	// Execute the query
+	rows, err := r.db.Query(query, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var documents []models.Document
+	for rows.Next() {
+		document, err := scanDocument(rows)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, *document)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return documents, nil
+}
+
+// GetByID returns a document with the specified ID
+func (r *DocumentRepository) GetByID(id int64) (*models.Document, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, entity_type, entity_id, file_name, stored_path, content_type, size, description, created_at
+		FROM documents
+		WHERE id = ?
+	`
+
+	// Execute the query
+	document, err := scanDocument(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("document not found")
+		}
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// GetByHouseID returns all documents attached to a house or to one of its meters
+func (r *DocumentRepository) GetByHouseID(houseID int64) ([]models.Document, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, entity_type, entity_id, file_name, stored_path, content_type, size, description, created_at
+		FROM documents
+		WHERE (entity_type = ? AND entity_id = ?)
+		   OR (entity_type = ? AND entity_id IN (SELECT id FROM meters WHERE house_id = ?))
+		ORDER BY created_at DESC, id DESC
+	`
+
+	// Execute the query
+	rows, err := r.db.Query(query, models.DocumentEntityHouse, houseID, models.DocumentEntityMeter, houseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var documents []models.Document
+	for rows.Next() {
+		document, err := scanDocument(rows)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, *document)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return documents, nil
+}
+
+// Delete removes a document's metadata from the database
+func (r *DocumentRepository) Delete(id int64) error {
+	// Ensure document exists
+	document, err := r.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// Documents of sold houses are frozen; those whose record is gone can always be removed
+	houseID, err := r.owningHouseID(document.EntityType, document.EntityID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		if err := ensureHouseWritable(r.db, houseID); err != nil {
+			return err
+		}
+	}
+
+	// Prepare the SQL statement
+	query := `DELETE FROM documents WHERE id = ?`
+
+	// Execute the query
+	_, err = r.db.Exec(query, id)
+	return err
+}
+
+// owningHouseID returns the house a record documents can be attached to belongs
+// to, or sql.ErrNoRows if the record does not exist
+func (r *DocumentRepository) owningHouseID(entityType models.DocumentEntityType, entityID int64) (int64, error) {
+	query, ok := documentOwnerQueries[entityType]
+	if !ok {
+		return 0, errors.New("documents cannot be attached to this kind of record")
+	}
+
+	var houseID int64
+	err := r.db.QueryRow(query, entityID).Scan(&houseID)
+	return houseID, err
+}
+
+// scanDocument reads a single document from the given row
+func scanDocument(row rowScanner) (*models.Document, error) {
+	var document models.Document
+	var createdAt string
+
+	err := row.Scan(
+		&document.ID,
+		&document.EntityType,
+		&document.EntityID,
+		&document.FileName,
+		&document.StoredPath,
+		&document.ContentType,
+		&document.Size,
+		&document.Description,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse timestamps
+	document.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+
+	return &document, nil
+}
@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"testing"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestDocumentRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	repo := NewDocumentRepository(db)
+
+	// Attach two documents to the house
+	lease := &models.Document{
+		EntityType:  models.DocumentEntityHouse,
+		EntityID:    house.ID,
+		FileName:    "purchase_contract.pdf",
+		StoredPath:  "house/1/a_purchase_contract.pdf",
+		ContentType: "application/pdf",
+		Size:        1024,
+	}
+	receipt := &models.Document{
+		EntityType: models.DocumentEntityHouse,
+		EntityID:   house.ID,
+		FileName:   "roof_repair.jpg",
+		StoredPath: "house/1/b_roof_repair.jpg",
+	}
+	for _, document := range []*models.Document{lease, receipt} {
+		if err := repo.Create(document); err != nil {
+			t.Fatalf("Error creating document: %v", err)
+		}
+		if document.ID == 0 {
+			t.Error("Document ID should not be 0 after creation")
+		}
+	}
+
+	// List documents of the house
+	documents, err := repo.GetByEntity(models.DocumentEntityHouse, house.ID)
+	if err != nil {
+		t.Fatalf("Error getting documents: %v", err)
+	}
+	if len(documents) != 2 || documents[0].ID != receipt.ID {
+		t.Errorf("Expected 2 documents newest first, got %v", documents)
+	}
+
+	// Test attaching to a non-existent house
+	orphan := &models.Document{
+		EntityType: models.DocumentEntityHouse,
+		EntityID:   9999,
+		FileName:   "x.pdf",
+		StoredPath: "house/9999/x.pdf",
+	}
+	if err := repo.Create(orphan); err == nil {
+		t.Error("Expected error for non-existent house, got nil")
+	}
+
+	// Test unknown entity type
+	orphan.EntityType = "spaceship"
+	orphan.EntityID = house.ID
+	if err := repo.Create(orphan); err == nil {
+		t.Error("Expected error for unknown entity type, got nil")
+	}
+
+	// Delete a document
+	if err := repo.Delete(lease.ID); err != nil {
+		t.Errorf("Error deleting document: %v", err)
+	}
+	if _, err := repo.GetByID(lease.ID); err == nil {
+		t.Error("Expected error when getting deleted document, got nil")
+	}
+	if err := repo.Delete(lease.ID); err == nil {
+		t.Error("Expected error for deleting non-existent document, got nil")
+	}
+}
+
+func TestDocumentRepository_ArchivedHouse(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	meter := testutil.CreateMeter(t, db, house.ID)
+	repo := NewDocumentRepository(db)
+
+	// Documents can be attached to the house and to its meters
+	contract := &models.Document{
+		EntityType: models.DocumentEntityHouse,
+		EntityID:   house.ID,
+		FileName:   "contract.pdf",
+		StoredPath: "house/1/a_contract.pdf",
+	}
+	certificate := &models.Document{
+		EntityType: models.DocumentEntityMeter,
+		EntityID:   meter.ID,
+		FileName:   "calibration.pdf",
+		StoredPath: "meter/1/a_calibration.pdf",
+	}
+	for _, document := range []*models.Document{contract, certificate} {
+		if err := repo.Create(document); err != nil {
+			t.Fatalf("Error creating document: %v", err)
+		}
+	}
+
+	documents, err := repo.GetByHouseID(house.ID)
+	if err != nil {
+		t.Fatalf("Error getting documents of house: %v", err)
+	}
+	if len(documents) != 2 {
+		t.Errorf("Expected documents of the house and its meter, got %v", documents)
+	}
+
+	// Once the house is sold its documents are frozen
+	if err := NewHouseRepository(db).Archive(house.ID, testutil.Date(2024, 6, 30)); err != nil {
+		t.Fatalf("Error archiving house: %v", err)
+	}
+
+	late := &models.Document{
+		EntityType: models.DocumentEntityMeter,
+		EntityID:   meter.ID,
+		FileName:   "late.pdf",
+		StoredPath: "meter/1/b_late.pdf",
+	}
+	if err := repo.Create(late); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived attaching to a meter of a sold house, got %v", err)
+	}
+	late.EntityType, late.EntityID = models.DocumentEntityHouse, house.ID
+	if err := repo.Create(late); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived attaching to a sold house, got %v", err)
+	}
+	if err := repo.Delete(contract.ID); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived deleting a document of a sold house, got %v", err)
+	}
+	if err := repo.Delete(certificate.ID); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived deleting a document of a sold house's meter, got %v", err)
+	}
+}
+
+func TestDocumentRepository_RemovedWithParent(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	meter := testutil.CreateMeter(t, db, house.ID)
+	other := testutil.CreateMeter(t, db, house.ID)
+	repo := NewDocumentRepository(db)
+
+	create := func(entityType models.DocumentEntityType, entityID int64, path string) *models.Document {
+		t.Helper()
+		document := &models.Document{EntityType: entityType, EntityID: entityID, FileName: "file.pdf", StoredPath: path}
+		if err := repo.Create(document); err != nil {
+			t.Fatalf("Error creating document: %v", err)
+		}
+		return document
+	}
+	houseDocument := create(models.DocumentEntityHouse, house.ID, "house/1/a.pdf")
+	meterDocument := create(models.DocumentEntityMeter, meter.ID, "meter/1/a.pdf")
+	otherDocument := create(models.DocumentEntityMeter, other.ID, "meter/2/a.pdf")
+
+	// Deleting a meter removes its documents only
+	if err := NewMeterRepository(db).Delete(meter.ID); err != nil {
+		t.Fatalf("Error deleting meter: %v", err)
+	}
+	if _, err := repo.GetByID(meterDocument.ID); err == nil {
+		t.Error("Expected the meter's document to be deleted with it")
+	}
+	if _, err := repo.GetByID(otherDocument.ID); err != nil {
+		t.Errorf("Expected documents of other meters to be kept, got %v", err)
+	}
+
+	// Deleting the house removes its documents and those of its remaining meters
+	if err := NewHouseRepository(db).Delete(house.ID); err != nil {
+		t.Fatalf("Error deleting house: %v", err)
+	}
+	for _, document := range []*models.Document{houseDocument, otherDocument} {
+		if _, err := repo.GetByID(document.ID); err == nil {
+			t.Errorf("Expected document %s to be deleted with the house", document.StoredPath)
+		}
+	}
+}
+
+func TestDocumentTriggers(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	meter := testutil.CreateMeter(t, db, house.ID)
+
+	// Writes bypassing the repository still cannot reference missing records
+	insert := func(entityType models.DocumentEntityType, entityID int64, path string) error {
+		_, err := db.Exec(
+			`INSERT INTO documents (entity_type, entity_id, file_name, stored_path) VALUES (?, ?, 'file.pdf', ?)`,
+			entityType, entityID, path,
+		)
+		return err
+	}
+	if err := insert(models.DocumentEntityHouse, 9999, "house/9999/a.pdf"); err == nil {
+		t.Error("Expected error inserting a document for a missing house, got nil")
+	}
+	if err := insert(models.DocumentEntityMeter, 9999, "meter/9999/a.pdf"); err == nil {
+		t.Error("Expected error inserting a document for a missing meter, got nil")
+	}
+	if err := insert("spaceship", house.ID, "spaceship/1/a.pdf"); err == nil {
+		t.Error("Expected error inserting a document for an unknown record type, got nil")
+	}
+	if err := insert(models.DocumentEntityHouse, house.ID, "house/1/a.pdf"); err != nil {
+		t.Fatalf("Error inserting house document: %v", err)
+	}
+	if err := insert(models.DocumentEntityMeter, meter.ID, "meter/1/a.pdf"); err != nil {
+		t.Fatalf("Error inserting meter document: %v", err)
+	}
+	if _, err := db.Exec(`UPDATE documents SET entity_id = 9999 WHERE stored_path = 'house/1/a.pdf'`); err == nil {
+		t.Error("Expected error moving a document to a missing house, got nil")
+	}
+
+	// Deleting the house directly removes its documents and, through the
+	// cascade to its meters, theirs as well
+	if _, err := db.Exec(`DELETE FROM houses WHERE id = ?`, house.ID); err != nil {
+		t.Fatalf("Error deleting house: %v", err)
+	}
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM documents`).Scan(&count); err != nil {
+		t.Fatalf("Error counting documents: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected no documents left after deleting the house, got %d", count)
+	}
+}
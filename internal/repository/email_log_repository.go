@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// EmailLogRepository handles all database interactions for the email send log
+type EmailLogRepository struct {
+	db Querier
+}
+
+// NewEmailLogRepository creates a new email log repository
+func NewEmailLogRepository(db Querier) *EmailLogRepository {
+	return &EmailLogRepository{db: db}
+}
+
+// Create adds a new entry to the send log
+func (r *EmailLogRepository) Create(entry *models.EmailLogEntry) error {
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO email_log (recipient, subject, attachments, status, error, sent_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	// Execute the query
+	now := time.Now()
+	result, err := r.db.Exec(
+		query,
+		entry.Recipient,
+		entry.Subject,
+		strings.Join(entry.Attachments, "\n"),
+		entry.Status,
+		entry.Error,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Get the inserted ID and update the entry object
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	entry.ID = id
+	entry.SentAt = now
+
+	return nil
+}
+
+// GetAll returns all send log entries, most recent first
+func (r *EmailLogRepository) GetAll() ([]models.EmailLogEntry, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, recipient, subject, attachments, status, error, sent_at
+		FROM email_log
+		ORDER BY sent_at DESC, id DESC
+	`
+
+	// Execute the query
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var entries []models.EmailLogEntry
+	for rows.Next() {
+		var entry models.EmailLogEntry
+		var attachments, sentAt string
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.Recipient,
+			&entry.Subject,
+			&attachments,
+			&entry.Status,
+			&entry.Error,
+			&sentAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if attachments != "" {
+			entry.Attachments = strings.Split(attachments, "\n")
+		}
+
+		// Parse timestamps
+		entry.SentAt, _ = time.Parse(time.RFC3339, sentAt)
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"testing"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestEmailLogRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewEmailLogRepository(db)
+
+	// Log a sent and a failed email
+	sent := &models.EmailLogEntry{
+		Recipient:   "mieter@example.com",
+		Subject:     "Statement",
+		Attachments: []string{"/tmp/a.pdf", "/tmp/b.pdf"},
+		Status:      models.EmailStatusSent,
+	}
+	failed := &models.EmailLogEntry{
+		Recipient: "mieter@example.com",
+		Subject:   "Reminder",
+		Status:    models.EmailStatusFailed,
+		Error:     "connection refused",
+	}
+	for _, entry := range []*models.EmailLogEntry{sent, failed} {
+		if err := repo.Create(entry); err != nil {
+			t.Fatalf("Error creating log entry: %v", err)
+		}
+		if entry.ID == 0 {
+			t.Error("Log entry ID should not be 0 after creation")
+		}
+	}
+
+	// Entries come back most recent first
+	entries, err := repo.GetAll()
+	if err != nil {
+		t.Fatalf("Error getting log entries: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(entries))
+	}
+	if entries[0].ID != failed.ID || entries[0].Error != "connection refused" {
+		t.Errorf("Unexpected first entry: %+v", entries[0])
+	}
+	if len(entries[1].Attachments) != 2 || entries[1].Attachments[1] != "/tmp/b.pdf" {
+		t.Errorf("Attachments were not properly stored: %v", entries[1].Attachments)
+	}
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// FeatureFlagRepository handles all database interactions for feature flags
+type FeatureFlagRepository struct {
+	db Querier
+}
+
+// NewFeatureFlagRepository creates a new feature flag repository
+func NewFeatureFlagRepository(db Querier) *FeatureFlagRepository {
+	return &FeatureFlagRepository{db: db}
+}
+
+// GetOverrides returns the features that have been switched on or off
+// explicitly for the given user. Settings made for the user take precedence
+// over those made for the whole installation; user ID 0 returns only the
+// latter.
+func (r *FeatureFlagRepository) GetOverrides(userID int64) (map[models.Feature]bool, error) {
+	// Execute the query, ordering installation-wide rows first so user rows win
+	rows, err := r.db.Query(`
+		SELECT name, enabled, 0 FROM feature_flags
+		UNION ALL
+		SELECT name, enabled, 1 FROM user_feature_flags WHERE user_id = ?
+		ORDER BY 3
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	overrides := make(map[models.Feature]bool)
+	for rows.Next() {
+		var name models.Feature
+		var enabled bool
+		var userRow int
+		if err := rows.Scan(&name, &enabled, &userRow); err != nil {
+			return nil, err
+		}
+		overrides[name] = enabled
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// Set switches a feature on or off for the given user, or for the whole
+// installation when the user ID is 0
+func (r *FeatureFlagRepository) Set(userID int64, name models.Feature, enabled bool) error {
+	// Ensure the feature exists in this version
+	if _, ok := models.LookupFeature(name); !ok {
+		return errors.New("unknown feature " + string(name))
+	}
+
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO feature_flags (name, enabled, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET enabled = excluded.enabled, updated_at = excluded.updated_at
+	`
+	args := []interface{}{name, enabled, time.Now()}
+	if userID != 0 {
+		query = `
+			INSERT INTO user_feature_flags (user_id, name, enabled, updated_at) VALUES (?, ?, ?, ?)
+			ON CONFLICT(user_id, name) DO UPDATE SET enabled = excluded.enabled, updated_at = excluded.updated_at
+		`
+		args = append([]interface{}{userID}, args...)
+	}
+
+	// Execute the query
+	_, err := r.db.Exec(query, args...)
+	return err
+}
+
+// Reset removes an explicit setting for the given user, or for the whole
+// installation when the user ID is 0, so the feature falls back to the next
+// level
+func (r *FeatureFlagRepository) Reset(userID int64, name models.Feature) error {
+	// Prepare the SQL statement
+	query := `DELETE FROM feature_flags WHERE name = ?`
+	args := []interface{}{name}
+	if userID != 0 {
+		query = `DELETE FROM user_feature_flags WHERE user_id = ? AND name = ?`
+		args = []interface{}{userID, name}
+	}
+
+	// Execute the query
+	_, err := r.db.Exec(query, args...)
+	return err
+}
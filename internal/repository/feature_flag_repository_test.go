@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"testing"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestFeatureFlagRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewFeatureFlagRepository(db)
+
+	// Nothing stored yet
+	overrides, err := repo.GetOverrides(0)
+	if err != nil {
+		t.Fatalf("Error getting overrides: %v", err)
+	}
+	if len(overrides) != 0 {
+		t.Errorf("Expected no overrides, got %v", overrides)
+	}
+
+	// Switch a feature off, then on again to exercise the upsert
+	if err := repo.Set(0, models.FeatureDocumentEmail, false); err != nil {
+		t.Fatalf("Error disabling feature: %v", err)
+	}
+	overrides, err = repo.GetOverrides(0)
+	if err != nil {
+		t.Fatalf("Error getting overrides: %v", err)
+	}
+	if enabled, ok := overrides[models.FeatureDocumentEmail]; !ok || enabled {
+		t.Errorf("Expected feature to be disabled, got %v", overrides)
+	}
+
+	if err := repo.Set(0, models.FeatureDocumentEmail, true); err != nil {
+		t.Fatalf("Error enabling feature: %v", err)
+	}
+	overrides, err = repo.GetOverrides(0)
+	if err != nil {
+		t.Fatalf("Error getting overrides: %v", err)
+	}
+	if !overrides[models.FeatureDocumentEmail] {
+		t.Errorf("Expected feature to be enabled, got %v", overrides)
+	}
+
+	// Test unknown feature
+	if err := repo.Set(0, "time_travel", true); err == nil {
+		t.Error("Expected error for unknown feature, got nil")
+	}
+
+	// Reset falls back to the default
+	if err := repo.Reset(0, models.FeatureDocumentEmail); err != nil {
+		t.Fatalf("Error resetting feature: %v", err)
+	}
+	overrides, err = repo.GetOverrides(0)
+	if err != nil {
+		t.Fatalf("Error getting overrides: %v", err)
+	}
+	if len(overrides) != 0 {
+		t.Errorf("Expected no overrides after reset, got %v", overrides)
+	}
+}
+
+func TestFeatureFlagRepository_PerUser(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewFeatureFlagRepository(db)
+	users := NewUserRepository(db)
+
+	owner := newTestUser("owner", models.RoleAdmin)
+	if err := users.Create(owner); err != nil {
+		t.Fatalf("Error creating owner: %v", err)
+	}
+	advisor := newTestUser("advisor", models.RoleViewer)
+	if err := users.Create(advisor); err != nil {
+		t.Fatalf("Error creating advisor: %v", err)
+	}
+
+	// The installation-wide setting applies to every user
+	if err := repo.Set(0, models.FeatureDocumentEmail, false); err != nil {
+		t.Fatalf("Error disabling feature: %v", err)
+	}
+
+	// A user's own setting wins over the installation-wide one
+	if err := repo.Set(owner.ID, models.FeatureDocumentEmail, true); err != nil {
+		t.Fatalf("Error enabling feature for owner: %v", err)
+	}
+	overrides, err := repo.GetOverrides(owner.ID)
+	if err != nil {
+		t.Fatalf("Error getting overrides: %v", err)
+	}
+	if !overrides[models.FeatureDocumentEmail] {
+		t.Errorf("Expected feature to be enabled for owner, got %v", overrides)
+	}
+
+	// Other users are not affected
+	overrides, err = repo.GetOverrides(advisor.ID)
+	if err != nil {
+		t.Fatalf("Error getting overrides: %v", err)
+	}
+	if enabled, ok := overrides[models.FeatureDocumentEmail]; !ok || enabled {
+		t.Errorf("Expected feature to be disabled for advisor, got %v", overrides)
+	}
+
+	// Resetting the user's setting falls back to the installation-wide one
+	if err := repo.Reset(owner.ID, models.FeatureDocumentEmail); err != nil {
+		t.Fatalf("Error resetting feature: %v", err)
+	}
+	overrides, err = repo.GetOverrides(owner.ID)
+	if err != nil {
+		t.Fatalf("Error getting overrides: %v", err)
+	}
+	if enabled, ok := overrides[models.FeatureDocumentEmail]; !ok || enabled {
+		t.Errorf("Expected installation-wide setting after reset, got %v", overrides)
+	}
+
+	// A user's settings are removed with the user
+	if err := repo.Set(advisor.ID, models.FeatureDocumentEmail, true); err != nil {
+		t.Fatalf("Error enabling feature for advisor: %v", err)
+	}
+	if err := users.Delete(advisor.ID); err != nil {
+		t.Fatalf("Error deleting advisor: %v", err)
+	}
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM user_feature_flags`).Scan(&count); err != nil {
+		t.Fatalf("Error counting user feature flags: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected no user feature flags after deleting the user, got %d", count)
+	}
+}
+
+func TestFeatureDefinition_IsEnabled(t *testing.T) {
+	stable := models.FeatureDefinition{Name: "stable", EnabledByDefault: true}
+	experimental := models.FeatureDefinition{Name: "experimental", Experimental: true, EnabledByDefault: true}
+
+	// Stable features follow their default unless overridden
+	if !stable.IsEnabled(nil, false) {
+		t.Error("Expected stable feature to be enabled by default")
+	}
+	if stable.IsEnabled(map[models.Feature]bool{"stable": false}, false) {
+		t.Error("Expected stable feature to be disabled by override")
+	}
+
+	// Experimental features need experimental mode and an explicit opt-in
+	if experimental.IsEnabled(map[models.Feature]bool{"experimental": true}, false) {
+		t.Error("Expected experimental feature to stay off without experimental mode")
+	}
+	if experimental.IsEnabled(nil, true) {
+		t.Error("Expected experimental feature to stay off without opt-in")
+	}
+	if !experimental.IsEnabled(map[models.Feature]bool{"experimental": true}, true) {
+		t.Error("Expected experimental feature to be enabled with opt-in in experimental mode")
+	}
+
+	// Resolving covers every known feature
+	flags := models.ResolveFeatureFlags(nil, false)
+	if len(flags) != len(models.FeatureDefinitions()) {
+		t.Errorf("Expected %d flags, got %d", len(models.FeatureDefinitions()), len(flags))
+	}
+}
@@ -8,13 +8,16 @@ import (
 	"property-management/internal/models"
 )
 
+// ErrHouseArchived is returned when modifying a house that has been sold
+var ErrHouseArchived = errors.New("house is archived and cannot be modified")
+
 // HouseRepository handles all database interactions for houses
 type HouseRepository struct {
-	db *sql.DB
+	db Querier
 }
 
 // NewHouseRepository creates a new house repository
-func NewHouseRepository(db *sql.DB) *HouseRepository {
+func NewHouseRepository(db Querier) *HouseRepository {
 	return &HouseRepository{db: db}
 }
 
@@ -61,17 +64,52 @@ func (r *HouseRepository) Create(house *models.House) error {
 	return nil
 }
 
-// GetAll returns all houses from the database
-func (r *HouseRepository) GetAll() ([]models.House, error) {
-	// Prepare the SQL statement
-	query := `
-		SELECT id, name, street, number, country, zip_code, city, created_at, updated_at
-		FROM houses
-		ORDER BY name
-	`
+// houseColumns lists the columns selected for every house query, in scan order
+const houseColumns = `id, name, street, number, country, zip_code, city, sale_date,
+	purchase_price, incidental_costs, land_value_share, created_at, updated_at`
 
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanHouse reads a single house from the given row
+func scanHouse(row rowScanner) (*models.House, error) {
+	var house models.House
+	var saleDate sql.NullString
+	var createdAt, updatedAt string
+
+	err := row.Scan(
+		&house.ID,
+		&house.Name,
+		&house.Street,
+		&house.Number,
+		&house.Country,
+		&house.ZipCode,
+		&house.City,
+		&saleDate,
+		&house.PurchasePrice,
+		&house.IncidentalCosts,
+		&house.LandValueShare,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse timestamps
+	house.SaleDate = parseNullTime(saleDate)
+	house.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	house.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+
+	return &house, nil
+}
+
+// queryHouses runs the given query and collects all resulting houses
+func (r *HouseRepository) queryHouses(query string, args ...interface{}) ([]models.House, error) {
 	// Execute the query
-	rows, err := r.db.Query(query)
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -80,29 +118,11 @@ func (r *HouseRepository) GetAll() ([]models.House, error) {
 	// Process the results
 	var houses []models.House
 	for rows.Next() {
-		var house models.House
-		var createdAt, updatedAt string
-
-		err := rows.Scan(
-			&house.ID,
-			&house.Name,
-			&house.Street,
-			&house.Number,
-			&house.Country,
-			&house.ZipCode,
-			&house.City,
-			&createdAt,
-			&updatedAt,
-		)
+		house, err := scanHouse(rows)
 		if err != nil {
 			return nil, err
 		}
-
-		// Parse timestamps
-		house.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
-		house.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
-
-		houses = append(houses, house)
+		houses = append(houses, *house)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -112,31 +132,87 @@ func (r *HouseRepository) GetAll() ([]models.House, error) {
 	return houses, nil
 }
 
+// GetAll returns all houses from the database, including archived ones
+func (r *HouseRepository) GetAll() ([]models.House, error) {
+	query := `SELECT ` + houseColumns + ` FROM houses ORDER BY name`
+	return r.queryHouses(query)
+}
+
+// GetActive returns all houses that have not been sold
+func (r *HouseRepository) GetActive() ([]models.House, error) {
+	query := `SELECT ` + houseColumns + ` FROM houses WHERE sale_date IS NULL ORDER BY name`
+	return r.queryHouses(query)
+}
+
+// GetArchived returns all sold houses, most recent sale first
+func (r *HouseRepository) GetArchived() ([]models.House, error) {
+	query := `SELECT ` + houseColumns + ` FROM houses WHERE sale_date IS NOT NULL ORDER BY sale_date DESC, name`
+	return r.queryHouses(query)
+}
+
+// houseSortColumns maps the sortable house fields to their columns
+var houseSortColumns = map[string]string{
+	"name":      "name",
+	"city":      "city",
+	"zipCode":   "zip_code",
+	"createdAt": "created_at",
+	"saleDate":  "sale_date",
+}
+
+// GetPage returns one page of houses, optionally including archived ones,
+// together with the total number of matching houses
+func (r *HouseRepository) GetPage(page models.PageRequest, includeArchived bool) (*models.HousePage, error) {
+	// Validate page request
+	if err := page.Validate(); err != nil {
+		return nil, err
+	}
+
+	column := "name"
+	if page.SortBy != "" {
+		var ok bool
+		column, ok = houseSortColumns[page.SortBy]
+		if !ok {
+			return nil, errors.New("houses cannot be sorted by " + page.SortBy)
+		}
+	}
+	direction := "ASC"
+	if page.Descending {
+		direction = "DESC"
+	}
+
+	where := `WHERE sale_date IS NULL`
+	if includeArchived {
+		where = ``
+	}
+
+	// Count all matches
+	result := models.HousePage{Houses: []models.House{}}
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM houses ` + where).Scan(&result.Total); err != nil {
+		return nil, err
+	}
+
+	// Prepare the SQL statement; the ID keeps the order stable between pages
+	query := `SELECT ` + houseColumns + ` FROM houses ` + where +
+		` ORDER BY ` + column + ` ` + direction + `, id ` + direction + ` LIMIT ? OFFSET ?`
+
+	houses, err := r.queryHouses(query, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+	if houses != nil {
+		result.Houses = houses
+	}
+
+	return &result, nil
+}
+
 // GetByID returns a house with the specified ID
 func (r *HouseRepository) GetByID(id int64) (*models.House, error) {
 	// Prepare the SQL statement
-	query := `
-		SELECT id, name, street, number, country, zip_code, city, created_at, updated_at
-		FROM houses
-		WHERE id = ?
-	`
+	query := `SELECT ` + houseColumns + ` FROM houses WHERE id = ?`
 
 	// Execute the query
-	var house models.House
-	var createdAt, updatedAt string
-
-	err := r.db.QueryRow(query, id).Scan(
-		&house.ID,
-		&house.Name,
-		&house.Street,
-		&house.Number,
-		&house.Country,
-		&house.ZipCode,
-		&house.City,
-		&createdAt,
-		&updatedAt,
-	)
-
+	house, err := scanHouse(r.db.QueryRow(query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, errors.New("house not found")
@@ -144,11 +220,7 @@ func (r *HouseRepository) GetByID(id int64) (*models.House, error) {
 		return nil, err
 	}
 
-	// Parse timestamps
-	house.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
-	house.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
-
-	return &house, nil
+	return house, nil
 }
 
 // Update modifies an existing house in the database
@@ -158,11 +230,14 @@ func (r *HouseRepository) Update(house *models.House) error {
 		return err
 	}
 
-	// Ensure house exists
-	_, err := r.GetByID(house.ID)
+	// Ensure house exists and has not been sold
+	existing, err := r.GetByID(house.ID)
 	if err != nil {
 		return err
 	}
+	if existing.IsArchived() {
+		return ErrHouseArchived
+	}
 
 	// Prepare the SQL statement
 	query := `
@@ -195,16 +270,96 @@ func (r *HouseRepository) Update(house *models.House) error {
 
 // Delete removes a house from the database
 func (r *HouseRepository) Delete(id int64) error {
-	// Ensure house exists
-	_, err := r.GetByID(id)
+	// Ensure house exists and has not been sold
+	existing, err := r.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if existing.IsArchived() {
+		return ErrHouseArchived
+	}
+
+	// Prepare the SQL statements
+	tx, err := begin(r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Execute the queries; documents are not linked by foreign keys, so
+	// remove those of the house and its meters before the records cascade away
+	_, err = tx.Exec(`
+		DELETE FROM documents
+		WHERE (entity_type = ? AND entity_id = ?)
+		   OR (entity_type = ? AND entity_id IN (SELECT id FROM meters WHERE house_id = ?))
+	`, models.DocumentEntityHouse, id, models.DocumentEntityMeter, id)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM houses WHERE id = ?`, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Archive marks a house as sold on the given date, freezing its data
+func (r *HouseRepository) Archive(id int64, saleDate time.Time) error {
+	// Ensure house exists and has not been sold already
+	existing, err := r.GetByID(id)
 	if err != nil {
 		return err
 	}
+	if existing.IsArchived() {
+		return ErrHouseArchived
+	}
 
 	// Prepare the SQL statement
-	query := `DELETE FROM houses WHERE id = ?`
+	query := `UPDATE houses SET sale_date = ?, updated_at = ? WHERE id = ?`
 
 	// Execute the query
-	_, err = r.db.Exec(query, id)
+	_, err = r.db.Exec(query, saleDate, time.Now(), id)
 	return err
 }
+
+// UpdateAcquisition stores the purchase price, incidental costs, and land value share of a house
+func (r *HouseRepository) UpdateAcquisition(house *models.House) error {
+	// Validate acquisition data
+	if err := house.ValidateAcquisition(); err != nil {
+		return err
+	}
+
+	// Ensure house exists and has not been sold
+	existing, err := r.GetByID(house.ID)
+	if err != nil {
+		return err
+	}
+	if existing.IsArchived() {
+		return ErrHouseArchived
+	}
+
+	// Prepare the SQL statement
+	query := `
+		UPDATE houses
+		SET purchase_price = ?, incidental_costs = ?, land_value_share = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	// Execute the query
+	now := time.Now()
+	_, err = r.db.Exec(
+		query,
+		house.PurchasePrice,
+		house.IncidentalCosts,
+		house.LandValueShare,
+		now,
+		house.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	house.UpdatedAt = now
+
+	return nil
+}
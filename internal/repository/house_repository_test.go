@@ -1,60 +1,15 @@
 package repository
 
 import (
-	"database/sql"
-	"os"
 	"testing"
+	"time"
 
 	"property-management/internal/models"
-
-	_ "github.com/mattn/go-sqlite3"
+	"property-management/internal/testutil"
 )
 
-func setupTestDB(t *testing.T) (*sql.DB, func()) {
-	// Create a temporary file for the test database
-	tmpfile, err := os.CreateTemp("", "test_property_management.db")
-	if err != nil {
-		t.Fatalf("Failed to create temp file: %v", err)
-	}
-	tmpfile.Close()
-
-	// Open the database connection
-	db, err := sql.Open("sqlite3", tmpfile.Name())
-	if err != nil {
-		t.Fatalf("Failed to open database: %v", err)
-	}
-
-	// Create the schema
-	const schema = `
-	CREATE TABLE IF NOT EXISTS houses (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		name TEXT NOT NULL,
-		street TEXT NOT NULL,
-		number TEXT NOT NULL,
-		country TEXT NOT NULL,
-		zip_code TEXT NOT NULL,
-		city TEXT NOT NULL,
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-	);`
-
-	_, err = db.Exec(schema)
-	if err != nil {
-		t.Fatalf("Failed to create schema: %v", err)
-	}
-
-	// Return cleanup function
-	cleanup := func() {
-		db.Close()
-		os.Remove(tmpfile.Name())
-	}
-
-	return db, cleanup
-}
-
 func TestHouseRepository_Create(t *testing.T) {
-	db, cleanup := setupTestDB(t)
-	defer cleanup()
+	db := testutil.NewDB(t)
 
 	repo := NewHouseRepository(db)
 
@@ -109,8 +64,7 @@ func TestHouseRepository_Create(t *testing.T) {
 }
 
 func TestHouseRepository_GetAll(t *testing.T) {
-	db, cleanup := setupTestDB(t)
-	defer cleanup()
+	db := testutil.NewDB(t)
 
 	repo := NewHouseRepository(db)
 
@@ -139,9 +93,73 @@ func TestHouseRepository_GetAll(t *testing.T) {
 	}
 }
 
+func TestHouseRepository_GetPage(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewHouseRepository(db)
+
+	// Create test houses, one of them sold
+	for _, name := range []string{"Cedar", "Alder", "Birch", "Elm", "Dogwood"} {
+		house := models.NewHouse(name, "Street", "1", "Germany", "10115", "Berlin")
+		if err := repo.Create(house); err != nil {
+			t.Fatalf("Error creating test house: %v", err)
+		}
+		if name == "Elm" {
+			if err := repo.Archive(house.ID, testutil.Date(2024, time.March, 1)); err != nil {
+				t.Fatalf("Error archiving test house: %v", err)
+			}
+		}
+	}
+
+	// First page of active houses in default order
+	page, err := repo.GetPage(models.PageRequest{Limit: 2}, false)
+	if err != nil {
+		t.Fatalf("Error getting page: %v", err)
+	}
+	if page.Total != 4 {
+		t.Errorf("Expected 4 active houses in total, got %d", page.Total)
+	}
+	if len(page.Houses) != 2 || page.Houses[0].Name != "Alder" || page.Houses[1].Name != "Birch" {
+		t.Errorf("Expected Alder and Birch on the first page, got %+v", page.Houses)
+	}
+
+	// Last page, sorted descending, including archived houses
+	page, err = repo.GetPage(models.PageRequest{Limit: 2, Offset: 4, SortBy: "name", Descending: true}, true)
+	if err != nil {
+		t.Fatalf("Error getting page: %v", err)
+	}
+	if page.Total != 5 {
+		t.Errorf("Expected 5 houses in total, got %d", page.Total)
+	}
+	if len(page.Houses) != 1 || page.Houses[0].Name != "Alder" {
+		t.Errorf("Expected only Alder on the last page, got %+v", page.Houses)
+	}
+
+	// Page past the end is empty, not nil
+	page, err = repo.GetPage(models.PageRequest{Limit: 2, Offset: 10}, false)
+	if err != nil {
+		t.Fatalf("Error getting page: %v", err)
+	}
+	if page.Houses == nil || len(page.Houses) != 0 {
+		t.Errorf("Expected an empty page, got %+v", page.Houses)
+	}
+
+	// Test unknown sort field
+	if _, err := repo.GetPage(models.PageRequest{Limit: 2, SortBy: "id; DROP TABLE houses"}, false); err == nil {
+		t.Error("Expected error for unknown sort field, got nil")
+	}
+
+	// Test invalid bounds
+	if _, err := repo.GetPage(models.PageRequest{Limit: 0}, false); err == nil {
+		t.Error("Expected error for zero page size, got nil")
+	}
+	if _, err := repo.GetPage(models.PageRequest{Limit: 10, Offset: -1}, false); err == nil {
+		t.Error("Expected error for negative offset, got nil")
+	}
+}
+
 func TestHouseRepository_GetByID(t *testing.T) {
-	db, cleanup := setupTestDB(t)
-	defer cleanup()
+	db := testutil.NewDB(t)
 
 	repo := NewHouseRepository(db)
 
@@ -178,8 +196,7 @@ func TestHouseRepository_GetByID(t *testing.T) {
 }
 
 func TestHouseRepository_Update(t *testing.T) {
-	db, cleanup := setupTestDB(t)
-	defer cleanup()
+	db := testutil.NewDB(t)
 
 	repo := NewHouseRepository(db)
 
@@ -235,8 +252,7 @@ func TestHouseRepository_Update(t *testing.T) {
 }
 
 func TestHouseRepository_Delete(t *testing.T) {
-	db, cleanup := setupTestDB(t)
-	defer cleanup()
+	db := testutil.NewDB(t)
 
 	repo := NewHouseRepository(db)
 
@@ -273,3 +289,124 @@ func TestHouseRepository_Delete(t *testing.T) {
 		t.Error("Expected error for deleting non-existent house, got nil")
 	}
 }
+
+func TestHouseRepository_Archive(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewHouseRepository(db)
+
+	// Create two test houses
+	kept := models.NewHouse("Kept House", "Street 1", "1", "Country", "11111", "City")
+	sold := models.NewHouse("Sold House", "Street 2", "2", "Country", "22222", "City")
+	for _, house := range []*models.House{kept, sold} {
+		if err := repo.Create(house); err != nil {
+			t.Fatalf("Error creating test house: %v", err)
+		}
+	}
+
+	// Archive one of them
+	saleDate := time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC)
+	if err := repo.Archive(sold.ID, saleDate); err != nil {
+		t.Fatalf("Error archiving house: %v", err)
+	}
+
+	// Verify the sale date was stored
+	retrievedHouse, err := repo.GetByID(sold.ID)
+	if err != nil {
+		t.Fatalf("Error getting archived house: %v", err)
+	}
+	if !retrievedHouse.IsArchived() || !retrievedHouse.SaleDate.Equal(saleDate) {
+		t.Errorf("Expected sale date %v, got %v", saleDate, retrievedHouse.SaleDate)
+	}
+
+	// Archived houses are excluded from active listings but kept in GetAll
+	active, err := repo.GetActive()
+	if err != nil {
+		t.Fatalf("Error getting active houses: %v", err)
+	}
+	if len(active) != 1 || active[0].ID != kept.ID {
+		t.Errorf("Expected only the kept house to be active, got %v", active)
+	}
+
+	archived, err := repo.GetArchived()
+	if err != nil {
+		t.Fatalf("Error getting archived houses: %v", err)
+	}
+	if len(archived) != 1 || archived[0].ID != sold.ID {
+		t.Errorf("Expected only the sold house to be archived, got %v", archived)
+	}
+
+	all, err := repo.GetAll()
+	if err != nil {
+		t.Fatalf("Error getting all houses: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("Expected 2 houses, got %d", len(all))
+	}
+
+	// Archived houses are read-only
+	retrievedHouse.Name = "Renamed"
+	if err := repo.Update(retrievedHouse); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived on update, got %v", err)
+	}
+	if err := repo.Delete(sold.ID); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived on delete, got %v", err)
+	}
+	if err := repo.Archive(sold.ID, saleDate); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived when archiving twice, got %v", err)
+	}
+}
+
+func TestHouseRepository_UpdateAcquisition(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewHouseRepository(db)
+	house := testutil.CreateHouse(t, db)
+
+	// Store acquisition data
+	house.PurchasePrice = 400000
+	house.IncidentalCosts = 40000
+	house.LandValueShare = 25
+	if err := repo.UpdateAcquisition(house); err != nil {
+		t.Fatalf("Error updating acquisition data: %v", err)
+	}
+
+	// Regular updates keep acquisition data
+	house.Name = "Renamed House"
+	if err := repo.Update(house); err != nil {
+		t.Fatalf("Error updating house: %v", err)
+	}
+
+	retrievedHouse, err := repo.GetByID(house.ID)
+	if err != nil {
+		t.Fatalf("Error getting house: %v", err)
+	}
+	if retrievedHouse.PurchasePrice != 400000 || retrievedHouse.IncidentalCosts != 40000 || retrievedHouse.LandValueShare != 25 {
+		t.Errorf("Acquisition data was not properly stored: %+v", retrievedHouse)
+	}
+
+	// Test invalid land value share
+	house.LandValueShare = 150
+	if err := repo.UpdateAcquisition(house); err == nil {
+		t.Error("Expected error for land value share above 100%, got nil")
+	}
+
+	// Test yield metrics
+	metrics, err := models.CalculateYieldMetrics(retrievedHouse, models.YieldInputs{
+		AnnualRent:           24000,
+		AnnualOperatingCosts: 2000,
+		AnnualDebtService:    12000,
+		Equity:               100000,
+	})
+	if err != nil {
+		t.Fatalf("Error calculating yield metrics: %v", err)
+	}
+	if metrics.GrossInitialYield != 6 || metrics.NetInitialYield != 5 || metrics.CashOnCashReturn != 10 {
+		t.Errorf("Unexpected yield metrics: %+v", metrics)
+	}
+
+	// Yields need a purchase price
+	if _, err := models.CalculateYieldMetrics(models.NewHouse("", "", "", "", "", ""), models.YieldInputs{}); err == nil {
+		t.Error("Expected error for missing purchase price, got nil")
+	}
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// IntegrityCheckRepository handles all database interactions for integrity check results
+type IntegrityCheckRepository struct {
+	db Querier
+}
+
+// NewIntegrityCheckRepository creates a new integrity check repository
+func NewIntegrityCheckRepository(db Querier) *IntegrityCheckRepository {
+	return &IntegrityCheckRepository{db: db}
+}
+
+// Create records the result of an integrity check
+func (r *IntegrityCheckRepository) Create(check *models.IntegrityCheck) error {
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO integrity_checks (checked_at, database_ok, backup_path, backup_ok, backup_skipped, error)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	// Execute the query
+	if check.CheckedAt.IsZero() {
+		check.CheckedAt = time.Now()
+	}
+	result, err := r.db.Exec(
+		query,
+		check.CheckedAt,
+		check.DatabaseOK,
+		check.BackupPath,
+		check.BackupOK,
+		check.BackupSkipped,
+		check.Error,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Get the inserted ID and update the check object
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	check.ID = id
+
+	return nil
+}
+
+// GetAll returns all recorded integrity checks, most recent first
+func (r *IntegrityCheckRepository) GetAll() ([]models.IntegrityCheck, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, checked_at, database_ok, backup_path, backup_ok, backup_skipped, error
+		FROM integrity_checks
+		ORDER BY checked_at DESC, id DESC
+	`
+
+	// Execute the query
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var checks []models.IntegrityCheck
+	for rows.Next() {
+		check, err := scanIntegrityCheck(rows)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, *check)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// GetLatest returns the most recent integrity check
+func (r *IntegrityCheckRepository) GetLatest() (*models.IntegrityCheck, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, checked_at, database_ok, backup_path, backup_ok, backup_skipped, error
+		FROM integrity_checks
+		ORDER BY checked_at DESC, id DESC
+		LIMIT 1
+	`
+
+	// Execute the query
+	check, err := scanIntegrityCheck(r.db.QueryRow(query))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("no integrity check has run yet")
+		}
+		return nil, err
+	}
+
+	return check, nil
+}
+
+// scanIntegrityCheck reads a single integrity check from the given row
+func scanIntegrityCheck(row rowScanner) (*models.IntegrityCheck, error) {
+	var check models.IntegrityCheck
+	var checkedAt string
+
+	err := row.Scan(
+		&check.ID,
+		&checkedAt,
+		&check.DatabaseOK,
+		&check.BackupPath,
+		&check.BackupOK,
+		&check.BackupSkipped,
+		&check.Error,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse timestamps
+	check.CheckedAt, _ = time.Parse(time.RFC3339, checkedAt)
+
+	return &check, nil
+}
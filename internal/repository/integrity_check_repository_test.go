@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestIntegrityCheckRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewIntegrityCheckRepository(db)
+
+	// No checks yet
+	if _, err := repo.GetLatest(); err == nil {
+		t.Error("Expected error before any check has run, got nil")
+	}
+
+	// Record a passed and a failed check
+	passed := &models.IntegrityCheck{
+		CheckedAt:  time.Now().Add(-7 * 24 * time.Hour),
+		DatabaseOK: true,
+		BackupPath: "/backups/old.db",
+		BackupOK:   true,
+	}
+	failed := &models.IntegrityCheck{
+		DatabaseOK: true,
+		Error:      "backup: no backups found",
+	}
+	for _, check := range []*models.IntegrityCheck{passed, failed} {
+		if err := repo.Create(check); err != nil {
+			t.Fatalf("Error recording integrity check: %v", err)
+		}
+	}
+
+	latest, err := repo.GetLatest()
+	if err != nil {
+		t.Fatalf("Error getting latest check: %v", err)
+	}
+	if latest.ID != failed.ID || latest.Passed() || latest.Error != failed.Error {
+		t.Errorf("Unexpected latest check: %+v", latest)
+	}
+
+	checks, err := repo.GetAll()
+	if err != nil {
+		t.Fatalf("Error getting checks: %v", err)
+	}
+	if len(checks) != 2 || !checks[1].Passed() {
+		t.Errorf("Unexpected check history: %+v", checks)
+	}
+}
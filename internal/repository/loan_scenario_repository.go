@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// LoanScenarioRepository handles all database interactions for loan refinancing scenarios
+type LoanScenarioRepository struct {
+	db Querier
+}
+
+// NewLoanScenarioRepository creates a new loan scenario repository
+func NewLoanScenarioRepository(db Querier) *LoanScenarioRepository {
+	return &LoanScenarioRepository{db: db}
+}
+
+// Create adds a new loan scenario to the database
+func (r *LoanScenarioRepository) Create(scenario *models.LoanScenario) error {
+	// Validate scenario data
+	if err := scenario.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure the house exists and has not been sold
+	if err := ensureHouseWritable(r.db, scenario.HouseID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO loan_scenarios (house_id, name, outstanding_balance, current_rate, current_remaining_months,
+			offer_rate, offer_term_months, offer_fees, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// Execute the query
+	now := time.Now()
+	result, err := r.db.Exec(
+		query,
+		scenario.HouseID,
+		scenario.Name,
+		scenario.OutstandingBalance,
+		scenario.CurrentRate,
+		scenario.CurrentRemainingMonths,
+		scenario.OfferRate,
+		scenario.OfferTermMonths,
+		scenario.OfferFees,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Get the inserted ID and update the scenario object
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	scenario.ID = id
+	scenario.CreatedAt = now
+	scenario.UpdatedAt = now
+
+	return nil
+}
+
+// GetByHouseID returns all loan scenarios of the specified house
+func (r *LoanScenarioRepository) GetByHouseID(houseID int64) ([]models.LoanScenario, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, house_id, name, outstanding_balance, current_rate, current_remaining_months,
+			offer_rate, offer_term_months, offer_fees, created_at, updated_at
+		FROM loan_scenarios
+		WHERE house_id = ?
+		ORDER BY name
+	`
+
+	// Execute the query
+	rows, err := r.db.Query(query, houseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var scenarios []models.LoanScenario
+	for rows.Next() {
+		scenario, err := scanLoanScenario(rows)
+		if err != nil {
+			return nil, err
+		}
+		scenarios = append(scenarios, *scenario)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return scenarios, nil
+}
+
+// GetByID returns a loan scenario with the specified ID
+func (r *LoanScenarioRepository) GetByID(id int64) (*models.LoanScenario, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, house_id, name, outstanding_balance, current_rate, current_remaining_months,
+			offer_rate, offer_term_months, offer_fees, created_at, updated_at
+		FROM loan_scenarios
+		WHERE id = ?
+	`
+
+	// Execute the query
+	scenario, err := scanLoanScenario(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("loan scenario not found")
+		}
+		return nil, err
+	}
+
+	return scenario, nil
+}
+
+// Update modifies an existing loan scenario in the database
+func (r *LoanScenarioRepository) Update(scenario *models.LoanScenario) error {
+	// Validate scenario data
+	if err := scenario.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure scenario exists
+	existing, err := r.GetByID(scenario.ID)
+	if err != nil {
+		return err
+	}
+
+	// Scenarios cannot be moved between houses
+	if existing.HouseID != scenario.HouseID {
+		return errors.New("loan scenario cannot be moved to a different house")
+	}
+
+	// Ensure the house has not been sold
+	if err := ensureHouseWritable(r.db, scenario.HouseID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `
+		UPDATE loan_scenarios
+		SET name = ?, outstanding_balance = ?, current_rate = ?, current_remaining_months = ?,
+			offer_rate = ?, offer_term_months = ?, offer_fees = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	// Execute the query
+	now := time.Now()
+	_, err = r.db.Exec(
+		query,
+		scenario.Name,
+		scenario.OutstandingBalance,
+		scenario.CurrentRate,
+		scenario.CurrentRemainingMonths,
+		scenario.OfferRate,
+		scenario.OfferTermMonths,
+		scenario.OfferFees,
+		now,
+		scenario.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	scenario.CreatedAt = existing.CreatedAt
+	scenario.UpdatedAt = now
+
+	return nil
+}
+
+// Delete removes a loan scenario from the database
+func (r *LoanScenarioRepository) Delete(id int64) error {
+	// Ensure scenario exists
+	existing, err := r.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the house has not been sold
+	if err := ensureHouseWritable(r.db, existing.HouseID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `DELETE FROM loan_scenarios WHERE id = ?`
+
+	// Execute the query
+	_, err = r.db.Exec(query, id)
+	return err
+}
+
+// scanLoanScenario reads a single loan scenario from the given row
+func scanLoanScenario(row rowScanner) (*models.LoanScenario, error) {
+	var scenario models.LoanScenario
+	var createdAt, updatedAt string
+
+	err := row.Scan(
+		&scenario.ID,
+		&scenario.HouseID,
+		&scenario.Name,
+		&scenario.OutstandingBalance,
+		&scenario.CurrentRate,
+		&scenario.CurrentRemainingMonths,
+		&scenario.OfferRate,
+		&scenario.OfferTermMonths,
+		&scenario.OfferFees,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse timestamps
+	scenario.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	scenario.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+
+	return &scenario, nil
+}
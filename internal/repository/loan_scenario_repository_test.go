@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"testing"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func newTestLoanScenario(houseID int64) *models.LoanScenario {
+	return &models.LoanScenario{
+		HouseID:                houseID,
+		Name:                   "Bank offer",
+		OutstandingBalance:     100000,
+		CurrentRate:            6,
+		CurrentRemainingMonths: 360,
+		OfferRate:              5,
+		OfferTermMonths:        360,
+		OfferFees:              2000,
+	}
+}
+
+func TestLoanScenarioRepository_Create(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	repo := NewLoanScenarioRepository(db)
+
+	// Test valid scenario
+	scenario := newTestLoanScenario(house.ID)
+	err := repo.Create(scenario)
+	if err != nil {
+		t.Errorf("Error creating loan scenario: %v", err)
+	}
+
+	if scenario.ID == 0 {
+		t.Error("Loan scenario ID should not be 0 after creation")
+	}
+
+	// Test invalid term
+	invalidScenario := newTestLoanScenario(house.ID)
+	invalidScenario.OfferTermMonths = 0
+	if err := repo.Create(invalidScenario); err == nil {
+		t.Error("Expected error for zero-month term, got nil")
+	}
+
+	// Test non-existent house
+	if err := repo.Create(newTestLoanScenario(9999)); err == nil {
+		t.Error("Expected error for non-existent house, got nil")
+	}
+}
+
+func TestLoanScenarioRepository_UpdateAndDelete(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	repo := NewLoanScenarioRepository(db)
+
+	scenario := newTestLoanScenario(house.ID)
+	if err := repo.Create(scenario); err != nil {
+		t.Fatalf("Error creating test loan scenario: %v", err)
+	}
+
+	// Update the scenario
+	scenario.Name = "Updated offer"
+	scenario.OfferRate = 4.5
+	if err := repo.Update(scenario); err != nil {
+		t.Errorf("Error updating loan scenario: %v", err)
+	}
+
+	scenarios, err := repo.GetByHouseID(house.ID)
+	if err != nil {
+		t.Fatalf("Error getting loan scenarios: %v", err)
+	}
+	if len(scenarios) != 1 || scenarios[0].Name != "Updated offer" || scenarios[0].OfferRate != 4.5 {
+		t.Errorf("Loan scenario was not properly updated: %v", scenarios)
+	}
+
+	// Delete the scenario
+	if err := repo.Delete(scenario.ID); err != nil {
+		t.Errorf("Error deleting loan scenario: %v", err)
+	}
+	if _, err := repo.GetByID(scenario.ID); err == nil {
+		t.Error("Expected error when getting deleted loan scenario, got nil")
+	}
+}
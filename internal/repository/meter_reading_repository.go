@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// MeterReadingRepository handles all database interactions for meter readings
+type MeterReadingRepository struct {
+	db Querier
+}
+
+// NewMeterReadingRepository creates a new meter reading repository
+func NewMeterReadingRepository(db Querier) *MeterReadingRepository {
+	return &MeterReadingRepository{db: db}
+}
+
+// Create adds a new meter reading to the database
+func (r *MeterReadingRepository) Create(reading *models.MeterReading) error {
+	// Validate reading data
+	if err := reading.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure the meter exists and its house has not been sold
+	if err := ensureMeterWritable(r.db, reading.MeterID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO meter_readings (meter_id, reading_date, value, note, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	// Execute the query
+	now := time.Now()
+	result, err := r.db.Exec(
+		query,
+		reading.MeterID,
+		reading.ReadingDate,
+		reading.Value,
+		reading.Note,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Get the inserted ID and update the reading object
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	reading.ID = id
+	reading.CreatedAt = now
+	reading.UpdatedAt = now
+
+	return nil
+}
+
+// GetByMeterID returns all readings of the specified meter ordered by date
+func (r *MeterReadingRepository) GetByMeterID(meterID int64) ([]models.MeterReading, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, meter_id, reading_date, value, note, created_at, updated_at
+		FROM meter_readings
+		WHERE meter_id = ?
+		ORDER BY reading_date
+	`
+
+	// Execute the query
+	rows, err := r.db.Query(query, meterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var readings []models.MeterReading
+	for rows.Next() {
+		reading, err := scanMeterReading(rows)
+		if err != nil {
+			return nil, err
+		}
+		readings = append(readings, *reading)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return readings, nil
+}
+
+// GetByID returns a meter reading with the specified ID
+func (r *MeterReadingRepository) GetByID(id int64) (*models.MeterReading, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, meter_id, reading_date, value, note, created_at, updated_at
+		FROM meter_readings
+		WHERE id = ?
+	`
+
+	// Execute the query
+	reading, err := scanMeterReading(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("meter reading not found")
+		}
+		return nil, err
+	}
+
+	return reading, nil
+}
+
+// Update modifies an existing meter reading in the database
+func (r *MeterReadingRepository) Update(reading *models.MeterReading) error {
+	// Validate reading data
+	if err := reading.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure reading exists
+	existing, err := r.GetByID(reading.ID)
+	if err != nil {
+		return err
+	}
+
+	// Readings cannot be moved between meters
+	if existing.MeterID != reading.MeterID {
+		return errors.New("reading cannot be moved to a different meter")
+	}
+
+	// Ensure the meter's house has not been sold
+	if err := ensureMeterWritable(r.db, reading.MeterID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `
+		UPDATE meter_readings
+		SET reading_date = ?, value = ?, note = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	// Execute the query
+	now := time.Now()
+	_, err = r.db.Exec(
+		query,
+		reading.ReadingDate,
+		reading.Value,
+		reading.Note,
+		now,
+		reading.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	reading.CreatedAt = existing.CreatedAt
+	reading.UpdatedAt = now
+
+	return nil
+}
+
+// Delete removes a meter reading from the database
+func (r *MeterReadingRepository) Delete(id int64) error {
+	// Ensure reading exists
+	existing, err := r.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the meter's house has not been sold
+	if err := ensureMeterWritable(r.db, existing.MeterID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `DELETE FROM meter_readings WHERE id = ?`
+
+	// Execute the query
+	_, err = r.db.Exec(query, id)
+	return err
+}
+
+// GetConsumption returns the consumption of a meter between two dates, using
+// the latest reading taken on or before each date
+func (r *MeterReadingRepository) GetConsumption(meterID int64, from, to time.Time) (float64, error) {
+	if to.Before(from) {
+		return 0, errors.New("end date cannot be before start date")
+	}
+
+	start, err := r.getLatestOnOrBefore(meterID, from)
+	if err != nil {
+		return 0, err
+	}
+
+	end, err := r.getLatestOnOrBefore(meterID, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return end.Value - start.Value, nil
+}
+
+// getLatestOnOrBefore returns the most recent reading of a meter taken on or before the given date
+func (r *MeterReadingRepository) getLatestOnOrBefore(meterID int64, date time.Time) (*models.MeterReading, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, meter_id, reading_date, value, note, created_at, updated_at
+		FROM meter_readings
+		WHERE meter_id = ? AND reading_date <= ?
+		ORDER BY reading_date DESC
+		LIMIT 1
+	`
+
+	// Execute the query
+	reading, err := scanMeterReading(r.db.QueryRow(query, meterID, date))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("no meter reading on or before %s", date.Format("2006-01-02"))
+		}
+		return nil, err
+	}
+
+	return reading, nil
+}
+
+// scanMeterReading reads a single meter reading from the given row
+func scanMeterReading(row rowScanner) (*models.MeterReading, error) {
+	var reading models.MeterReading
+	var readingDate, createdAt, updatedAt string
+
+	err := row.Scan(
+		&reading.ID,
+		&reading.MeterID,
+		&readingDate,
+		&reading.Value,
+		&reading.Note,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse timestamps
+	reading.ReadingDate, _ = time.Parse(time.RFC3339, readingDate)
+	reading.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	reading.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+
+	return &reading, nil
+}
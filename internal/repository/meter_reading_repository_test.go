@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestMeterReadingRepository_Create(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	meter := testutil.CreateMeter(t, db, house.ID)
+	repo := NewMeterReadingRepository(db)
+
+	// Test valid reading
+	reading := models.NewMeterReading(meter.ID, testutil.Date(2024, time.January, 1), 100.5, "Annual reading")
+	err := repo.Create(reading)
+	if err != nil {
+		t.Errorf("Error creating reading: %v", err)
+	}
+
+	if reading.ID == 0 {
+		t.Error("Reading ID should not be 0 after creation")
+	}
+
+	retrievedReading, err := repo.GetByID(reading.ID)
+	if err != nil {
+		t.Fatalf("Error getting reading: %v", err)
+	}
+	if !retrievedReading.ReadingDate.Equal(reading.ReadingDate) || retrievedReading.Value != 100.5 {
+		t.Errorf("Retrieved reading does not match the original")
+	}
+
+	// Test duplicate reading on the same day
+	duplicate := models.NewMeterReading(meter.ID, testutil.Date(2024, time.January, 1), 101, "")
+	if err := repo.Create(duplicate); err == nil {
+		t.Error("Expected error for duplicate reading date, got nil")
+	}
+
+	// Test negative value
+	negative := models.NewMeterReading(meter.ID, testutil.Date(2024, time.February, 1), -1, "")
+	if err := repo.Create(negative); err == nil {
+		t.Error("Expected error for negative reading, got nil")
+	}
+
+	// Test non-existent meter
+	orphan := models.NewMeterReading(9999, testutil.Date(2024, time.February, 1), 1, "")
+	if err := repo.Create(orphan); err == nil {
+		t.Error("Expected error for non-existent meter, got nil")
+	}
+}
+
+func TestMeterReadingRepository_Consumption(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	meter := testutil.CreateMeter(t, db, house.ID)
+	repo := NewMeterReadingRepository(db)
+
+	// Create readings out of order
+	values := map[time.Time]float64{
+		testutil.Date(2024, time.July, 1):    160,
+		testutil.Date(2024, time.January, 1): 100,
+		testutil.Date(2025, time.January, 1): 230,
+	}
+	for readingDate, value := range values {
+		if err := repo.Create(models.NewMeterReading(meter.ID, readingDate, value, "")); err != nil {
+			t.Fatalf("Error creating test reading: %v", err)
+		}
+	}
+
+	// Readings are returned ordered by date
+	readings, err := repo.GetByMeterID(meter.ID)
+	if err != nil {
+		t.Fatalf("Error getting readings: %v", err)
+	}
+	if len(readings) != 3 || readings[0].Value != 100 || readings[2].Value != 230 {
+		t.Fatalf("Unexpected readings order: %v", readings)
+	}
+
+	// Consumption deltas between consecutive readings
+	deltas := models.ConsumptionDeltas(readings)
+	if len(deltas) != 2 || deltas[0].Consumption != 60 || deltas[1].Consumption != 70 {
+		t.Errorf("Unexpected consumption deltas: %v", deltas)
+	}
+
+	// Consumption over the year
+	consumption, err := repo.GetConsumption(meter.ID, testutil.Date(2024, time.January, 1), testutil.Date(2025, time.January, 1))
+	if err != nil {
+		t.Fatalf("Error getting consumption: %v", err)
+	}
+	if consumption != 130 {
+		t.Errorf("Expected consumption 130, got %v", consumption)
+	}
+
+	// Dates between readings use the latest earlier reading
+	consumption, err = repo.GetConsumption(meter.ID, testutil.Date(2024, time.March, 15), testutil.Date(2024, time.December, 31))
+	if err != nil {
+		t.Fatalf("Error getting consumption: %v", err)
+	}
+	if consumption != 60 {
+		t.Errorf("Expected consumption 60, got %v", consumption)
+	}
+
+	// No reading before the start date
+	if _, err := repo.GetConsumption(meter.ID, testutil.Date(2023, time.January, 1), testutil.Date(2024, time.July, 1)); err == nil {
+		t.Error("Expected error for missing start reading, got nil")
+	}
+
+	// Reversed period
+	if _, err := repo.GetConsumption(meter.ID, testutil.Date(2025, time.January, 1), testutil.Date(2024, time.January, 1)); err == nil {
+		t.Error("Expected error for reversed period, got nil")
+	}
+}
+
+func TestMeterReadingRepository_UpdateAndDelete(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	houseRepo := NewHouseRepository(db)
+	house := testutil.CreateHouse(t, db)
+	meter := testutil.CreateMeter(t, db, house.ID)
+	repo := NewMeterReadingRepository(db)
+
+	reading := models.NewMeterReading(meter.ID, testutil.Date(2024, time.January, 1), 100, "")
+	if err := repo.Create(reading); err != nil {
+		t.Fatalf("Error creating test reading: %v", err)
+	}
+
+	// Update the reading
+	reading.Value = 105
+	reading.Note = "Corrected"
+	if err := repo.Update(reading); err != nil {
+		t.Errorf("Error updating reading: %v", err)
+	}
+
+	retrievedReading, err := repo.GetByID(reading.ID)
+	if err != nil {
+		t.Fatalf("Error getting updated reading: %v", err)
+	}
+	if retrievedReading.Value != 105 || retrievedReading.Note != "Corrected" {
+		t.Errorf("Reading was not properly updated")
+	}
+
+	// Readings of sold houses are frozen
+	if err := houseRepo.Archive(house.ID, testutil.Date(2024, time.June, 30)); err != nil {
+		t.Fatalf("Error archiving house: %v", err)
+	}
+	if err := repo.Delete(reading.ID); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived, got %v", err)
+	}
+	if err := repo.Create(models.NewMeterReading(meter.ID, testutil.Date(2024, time.July, 1), 110, "")); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived, got %v", err)
+	}
+}
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// MeterRepository handles all database interactions for meters
+type MeterRepository struct {
+	db Querier
+}
+
+// NewMeterRepository creates a new meter repository
+func NewMeterRepository(db Querier) *MeterRepository {
+	return &MeterRepository{db: db}
+}
+
+// Create adds a new meter to the database
+func (r *MeterRepository) Create(meter *models.Meter) error {
+	// Validate meter data
+	if err := meter.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure the house exists and has not been sold
+	if err := ensureHouseWritable(r.db, meter.HouseID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO meters (house_id, type, serial_number, location, unit, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// Execute the query
+	now := time.Now()
+	result, err := r.db.Exec(
+		query,
+		meter.HouseID,
+		meter.Type,
+		meter.SerialNumber,
+		meter.Location,
+		meter.Unit,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Get the inserted ID and update the meter object
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	meter.ID = id
+	meter.CreatedAt = now
+	meter.UpdatedAt = now
+
+	return nil
+}
+
+// GetByHouseID returns all meters installed in the specified house
+func (r *MeterRepository) GetByHouseID(houseID int64) ([]models.Meter, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, house_id, type, serial_number, location, unit, created_at, updated_at
+		FROM meters
+		WHERE house_id = ?
+		ORDER BY type, location, serial_number
+	`
+
+	// Execute the query
+	rows, err := r.db.Query(query, houseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var meters []models.Meter
+	for rows.Next() {
+		meter, err := scanMeter(rows)
+		if err != nil {
+			return nil, err
+		}
+		meters = append(meters, *meter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return meters, nil
+}
+
+// GetByID returns a meter with the specified ID
+func (r *MeterRepository) GetByID(id int64) (*models.Meter, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, house_id, type, serial_number, location, unit, created_at, updated_at
+		FROM meters
+		WHERE id = ?
+	`
+
+	// Execute the query
+	meter, err := scanMeter(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("meter not found")
+		}
+		return nil, err
+	}
+
+	return meter, nil
+}
+
+// Update modifies an existing meter in the database
+func (r *MeterRepository) Update(meter *models.Meter) error {
+	// Validate meter data
+	if err := meter.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure meter exists
+	existing, err := r.GetByID(meter.ID)
+	if err != nil {
+		return err
+	}
+
+	// Meters cannot be moved between houses
+	if existing.HouseID != meter.HouseID {
+		return errors.New("meter cannot be moved to a different house")
+	}
+
+	// Ensure the house has not been sold
+	if err := ensureHouseWritable(r.db, meter.HouseID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `
+		UPDATE meters
+		SET type = ?, serial_number = ?, location = ?, unit = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	// Execute the query
+	now := time.Now()
+	_, err = r.db.Exec(
+		query,
+		meter.Type,
+		meter.SerialNumber,
+		meter.Location,
+		meter.Unit,
+		now,
+		meter.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	meter.CreatedAt = existing.CreatedAt
+	meter.UpdatedAt = now
+
+	return nil
+}
+
+// Delete removes a meter and all of its readings from the database
+func (r *MeterRepository) Delete(id int64) error {
+	// Ensure meter exists and its house has not been sold
+	if err := ensureMeterWritable(r.db, id); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statements
+	tx, err := begin(r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Execute the queries (readings first, in case foreign keys are disabled)
+	if _, err := tx.Exec(`DELETE FROM meter_readings WHERE meter_id = ?`, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM documents WHERE entity_type = ? AND entity_id = ?`, models.DocumentEntityMeter, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM meters WHERE id = ?`, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// scanMeter reads a single meter from the given row
+func scanMeter(row rowScanner) (*models.Meter, error) {
+	var meter models.Meter
+	var createdAt, updatedAt string
+
+	err := row.Scan(
+		&meter.ID,
+		&meter.HouseID,
+		&meter.Type,
+		&meter.SerialNumber,
+		&meter.Location,
+		&meter.Unit,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse timestamps
+	meter.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	meter.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+
+	return &meter, nil
+}
+
+// ensureMeterWritable returns an error if the meter does not exist or its house has been archived
+func ensureMeterWritable(db Querier, meterID int64) error {
+	var saleDate sql.NullString
+	query := `
+		SELECT h.sale_date
+		FROM meters m
+		JOIN houses h ON h.id = m.house_id
+		WHERE m.id = ?
+	`
+	err := db.QueryRow(query, meterID).Scan(&saleDate)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New("meter not found")
+		}
+		return err
+	}
+	if saleDate.Valid {
+		return ErrHouseArchived
+	}
+	return nil
+}
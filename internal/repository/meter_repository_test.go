@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestMeterRepository_Create(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	repo := NewMeterRepository(db)
+
+	// Test valid meter with default unit
+	meter := models.NewMeter(house.ID, models.MeterTypeWater, "W-123", "Basement", "")
+	err := repo.Create(meter)
+	if err != nil {
+		t.Errorf("Error creating meter: %v", err)
+	}
+
+	if meter.ID == 0 {
+		t.Error("Meter ID should not be 0 after creation")
+	}
+	if meter.Unit != "m³" {
+		t.Errorf("Expected default unit m³, got %q", meter.Unit)
+	}
+
+	// Test invalid meter type
+	invalidMeter := models.NewMeter(house.ID, models.MeterType("steam"), "S-1", "", "t")
+	if err := repo.Create(invalidMeter); err == nil {
+		t.Error("Expected error for invalid meter type, got nil")
+	}
+
+	// Test missing serial number
+	invalidMeter = models.NewMeter(house.ID, models.MeterTypeHeat, "", "", "")
+	if err := repo.Create(invalidMeter); err == nil {
+		t.Error("Expected error for missing serial number, got nil")
+	}
+
+	// Test non-existent house
+	invalidMeter = models.NewMeter(9999, models.MeterTypeHeat, "H-1", "", "")
+	if err := repo.Create(invalidMeter); err == nil {
+		t.Error("Expected error for non-existent house, got nil")
+	}
+}
+
+func TestMeterRepository_GetByHouseID(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	otherHouse := testutil.CreateHouse(t, db)
+	repo := NewMeterRepository(db)
+
+	// Create test meters
+	meters := []*models.Meter{
+		models.NewMeter(house.ID, models.MeterTypeWater, "W-1", "Basement", ""),
+		models.NewMeter(house.ID, models.MeterTypeElectricity, "E-1", "Hallway", ""),
+		models.NewMeter(otherHouse.ID, models.MeterTypeHeat, "H-1", "Attic", ""),
+	}
+	for _, meter := range meters {
+		if err := repo.Create(meter); err != nil {
+			t.Fatalf("Error creating test meter: %v", err)
+		}
+	}
+
+	// Test GetByHouseID
+	retrievedMeters, err := repo.GetByHouseID(house.ID)
+	if err != nil {
+		t.Errorf("Error getting meters by house: %v", err)
+	}
+
+	if len(retrievedMeters) != 2 {
+		t.Errorf("Expected 2 meters, got %d", len(retrievedMeters))
+	}
+}
+
+func TestMeterRepository_UpdateAndDelete(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	house := testutil.CreateHouse(t, db)
+	repo := NewMeterRepository(db)
+	readingRepo := NewMeterReadingRepository(db)
+
+	meter := models.NewMeter(house.ID, models.MeterTypeWater, "W-1", "Basement", "")
+	if err := repo.Create(meter); err != nil {
+		t.Fatalf("Error creating test meter: %v", err)
+	}
+
+	// Update the meter
+	meter.SerialNumber = "W-2"
+	meter.Location = "Kitchen"
+	if err := repo.Update(meter); err != nil {
+		t.Errorf("Error updating meter: %v", err)
+	}
+
+	retrievedMeter, err := repo.GetByID(meter.ID)
+	if err != nil {
+		t.Fatalf("Error getting updated meter: %v", err)
+	}
+	if retrievedMeter.SerialNumber != "W-2" || retrievedMeter.Location != "Kitchen" {
+		t.Errorf("Meter was not properly updated")
+	}
+
+	// Meters cannot move between houses
+	meter.HouseID = 9999
+	if err := repo.Update(meter); err == nil {
+		t.Error("Expected error for moving meter to another house, got nil")
+	}
+
+	// Deleting a meter removes its readings
+	reading := models.NewMeterReading(meter.ID, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 10, "")
+	if err := readingRepo.Create(reading); err != nil {
+		t.Fatalf("Error creating test reading: %v", err)
+	}
+
+	if err := repo.Delete(meter.ID); err != nil {
+		t.Errorf("Error deleting meter: %v", err)
+	}
+	if _, err := repo.GetByID(meter.ID); err == nil {
+		t.Error("Expected error when getting deleted meter, got nil")
+	}
+	if _, err := readingRepo.GetByID(reading.ID); err == nil {
+		t.Error("Expected reading to be deleted with its meter")
+	}
+
+	// Test deleting non-existent meter
+	if err := repo.Delete(9999); err == nil {
+		t.Error("Expected error for deleting non-existent meter, got nil")
+	}
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// ErrPropertyManagerNotFound is returned when a house has no property manager
+var ErrPropertyManagerNotFound = errors.New("property manager not found")
+
+// PropertyManagerRepository handles all database interactions for external property managers
+type PropertyManagerRepository struct {
+	db Querier
+}
+
+// NewPropertyManagerRepository creates a new property manager repository
+func NewPropertyManagerRepository(db Querier) *PropertyManagerRepository {
+	return &PropertyManagerRepository{db: db}
+}
+
+// GetByHouseID returns the property manager of the specified house
+func (r *PropertyManagerRepository) GetByHouseID(houseID int64) (*models.PropertyManager, error) {
+	// Prepare the SQL statement
+	query := `
+		SELECT id, house_id, company_name, contact_name, street, number, country, zip_code, city,
+			phone, email, mandate_start, mandate_end, use_for_correspondence, created_at, updated_at
+		FROM property_managers
+		WHERE house_id = ?
+	`
+
+	// Execute the query
+	var manager models.PropertyManager
+	var mandateStart, mandateEnd sql.NullString
+	var createdAt, updatedAt string
+
+	err := r.db.QueryRow(query, houseID).Scan(
+		&manager.ID,
+		&manager.HouseID,
+		&manager.CompanyName,
+		&manager.ContactName,
+		&manager.Street,
+		&manager.Number,
+		&manager.Country,
+		&manager.ZipCode,
+		&manager.City,
+		&manager.Phone,
+		&manager.Email,
+		&mandateStart,
+		&mandateEnd,
+		&manager.UseForCorrespondence,
+		&createdAt,
+		&updatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrPropertyManagerNotFound
+		}
+		return nil, err
+	}
+
+	// Parse timestamps
+	manager.MandateStart = parseNullTime(mandateStart)
+	manager.MandateEnd = parseNullTime(mandateEnd)
+	manager.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	manager.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+
+	return &manager, nil
+}
+
+// Save creates or replaces the property manager of a house
+func (r *PropertyManagerRepository) Save(manager *models.PropertyManager) error {
+	// Validate property manager data
+	if err := manager.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure the house exists and has not been sold
+	if err := ensureHouseWritable(r.db, manager.HouseID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO property_managers (house_id, company_name, contact_name, street, number, country, zip_code, city,
+			phone, email, mandate_start, mandate_end, use_for_correspondence, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(house_id) DO UPDATE SET
+			company_name = excluded.company_name,
+			contact_name = excluded.contact_name,
+			street = excluded.street,
+			number = excluded.number,
+			country = excluded.country,
+			zip_code = excluded.zip_code,
+			city = excluded.city,
+			phone = excluded.phone,
+			email = excluded.email,
+			mandate_start = excluded.mandate_start,
+			mandate_end = excluded.mandate_end,
+			use_for_correspondence = excluded.use_for_correspondence,
+			updated_at = excluded.updated_at
+	`
+
+	// Execute the query
+	now := time.Now()
+	_, err := r.db.Exec(
+		query,
+		manager.HouseID,
+		manager.CompanyName,
+		manager.ContactName,
+		manager.Street,
+		manager.Number,
+		manager.Country,
+		manager.ZipCode,
+		manager.City,
+		manager.Phone,
+		manager.Email,
+		manager.MandateStart,
+		manager.MandateEnd,
+		manager.UseForCorrespondence,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Reload to pick up the ID and original creation time on updates
+	saved, err := r.GetByHouseID(manager.HouseID)
+	if err != nil {
+		return err
+	}
+	*manager = *saved
+
+	return nil
+}
+
+// DeleteByHouseID removes the property manager of a house
+func (r *PropertyManagerRepository) DeleteByHouseID(houseID int64) error {
+	// Ensure the house exists and has not been sold
+	if err := ensureHouseWritable(r.db, houseID); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `DELETE FROM property_managers WHERE house_id = ?`
+
+	// Execute the query
+	result, err := r.db.Exec(query, houseID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrPropertyManagerNotFound
+	}
+
+	return nil
+}
+
+// ensureHouseWritable returns an error if the house does not exist or has been archived
+func ensureHouseWritable(db Querier, houseID int64) error {
+	var saleDate sql.NullString
+	err := db.QueryRow(`SELECT sale_date FROM houses WHERE id = ?`, houseID).Scan(&saleDate)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New("house not found")
+		}
+		return err
+	}
+	if saleDate.Valid {
+		return ErrHouseArchived
+	}
+	return nil
+}
+
+// parseNullTime converts a nullable timestamp column into a time pointer
+func parseNullTime(value sql.NullString) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value.String)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestPropertyManagerRepository_Save(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	houseRepo := NewHouseRepository(db)
+	repo := NewPropertyManagerRepository(db)
+
+	// Create a test house
+	house := models.NewHouse("Test House", "Test Street", "123", "Test Country", "12345", "Test City")
+	if err := houseRepo.Create(house); err != nil {
+		t.Fatalf("Error creating test house: %v", err)
+	}
+
+	// Test creating a manager
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	manager := &models.PropertyManager{
+		HouseID:              house.ID,
+		CompanyName:          "Muster Hausverwaltung GmbH",
+		Email:                "info@muster-hv.de",
+		MandateStart:         &start,
+		UseForCorrespondence: true,
+	}
+
+	err := repo.Save(manager)
+	if err != nil {
+		t.Fatalf("Error saving property manager: %v", err)
+	}
+
+	if manager.ID == 0 {
+		t.Error("Property manager ID should not be 0 after saving")
+	}
+
+	// Test replacing the manager keeps a single row per house
+	manager.CompanyName = "Neue Verwaltung KG"
+	if err := repo.Save(manager); err != nil {
+		t.Fatalf("Error updating property manager: %v", err)
+	}
+
+	retrieved, err := repo.GetByHouseID(house.ID)
+	if err != nil {
+		t.Fatalf("Error getting property manager: %v", err)
+	}
+
+	if retrieved.ID != manager.ID || retrieved.CompanyName != "Neue Verwaltung KG" {
+		t.Errorf("Property manager was not properly updated")
+	}
+	if retrieved.MandateStart == nil || !retrieved.MandateStart.Equal(start) || retrieved.MandateEnd != nil {
+		t.Errorf("Mandate period was not properly stored: %v - %v", retrieved.MandateStart, retrieved.MandateEnd)
+	}
+
+	// Correspondence is only rerouted during the mandate
+	if !retrieved.RoutesCorrespondence(start.AddDate(0, 6, 0)) {
+		t.Error("Expected correspondence to be rerouted during the mandate")
+	}
+	if retrieved.RoutesCorrespondence(start.AddDate(0, 0, -1)) {
+		t.Error("Expected correspondence not to be rerouted before the mandate")
+	}
+
+	// Test invalid mandate period
+	end := start.AddDate(0, 0, -1)
+	manager.MandateEnd = &end
+	if err := repo.Save(manager); err == nil {
+		t.Error("Expected error for mandate ending before it starts, got nil")
+	}
+
+	// Test saving for a non-existent house
+	orphan := &models.PropertyManager{HouseID: 9999, CompanyName: "Orphan"}
+	if err := repo.Save(orphan); err == nil {
+		t.Error("Expected error for non-existent house, got nil")
+	}
+
+	// Test saving for an archived house
+	if err := houseRepo.Archive(house.ID, time.Now()); err != nil {
+		t.Fatalf("Error archiving house: %v", err)
+	}
+	manager.MandateEnd = nil
+	if err := repo.Save(manager); err != ErrHouseArchived {
+		t.Errorf("Expected ErrHouseArchived, got %v", err)
+	}
+}
+
+func TestPropertyManagerRepository_DeleteByHouseID(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	houseRepo := NewHouseRepository(db)
+	repo := NewPropertyManagerRepository(db)
+
+	// Create a test house with a manager
+	house := models.NewHouse("Test House", "Test Street", "123", "Test Country", "12345", "Test City")
+	if err := houseRepo.Create(house); err != nil {
+		t.Fatalf("Error creating test house: %v", err)
+	}
+
+	manager := &models.PropertyManager{HouseID: house.ID, CompanyName: "Muster Hausverwaltung GmbH"}
+	if err := repo.Save(manager); err != nil {
+		t.Fatalf("Error saving property manager: %v", err)
+	}
+
+	// Delete the manager
+	if err := repo.DeleteByHouseID(house.ID); err != nil {
+		t.Errorf("Error deleting property manager: %v", err)
+	}
+
+	// Verify the deletion
+	if _, err := repo.GetByHouseID(house.ID); err == nil {
+		t.Error("Expected error when getting deleted property manager, got nil")
+	}
+
+	// Test deleting again
+	if err := repo.DeleteByHouseID(house.ID); err == nil {
+		t.Error("Expected error for deleting non-existent property manager, got nil")
+	}
+}
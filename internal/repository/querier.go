@@ -0,0 +1,50 @@
+package repository
+
+import "database/sql"
+
+// Querier is implemented by both *sql.DB and *sql.Tx, so repositories can be
+// bound to a transaction when several changes must succeed or fail together
+type Querier interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// transaction groups the statements of one repository method. On a
+// connection it is a transaction of its own; on a repository already bound
+// to a transaction it runs inside that one and leaves committing to its owner.
+type transaction struct {
+	Querier
+	tx *sql.Tx
+}
+
+// begin starts a transaction on db unless db already is one
+func begin(db Querier) (*transaction, error) {
+	conn, ok := db.(*sql.DB)
+	if !ok {
+		return &transaction{Querier: db}, nil
+	}
+
+	tx, err := conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{Querier: tx, tx: tx}, nil
+}
+
+// Commit commits the transaction if it was started by begin
+func (t *transaction) Commit() error {
+	if t.tx == nil {
+		return nil
+	}
+	return t.tx.Commit()
+}
+
+// Rollback rolls the transaction back if it was started by begin; the
+// enclosing transaction's owner rolls back everything else
+func (t *transaction) Rollback() error {
+	if t.tx == nil {
+		return nil
+	}
+	return t.tx.Rollback()
+}
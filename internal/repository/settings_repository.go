@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// Keys under which the individual settings are stored
+const (
+	settingMarginalTaxRate    = "marginal_tax_rate"
+	settingShowPostTaxFigures = "show_post_tax_figures"
+	settingSMTPHost           = "smtp_host"
+	settingSMTPPort           = "smtp_port"
+	settingSMTPUsername       = "smtp_username"
+	settingSMTPPassword       = "smtp_password"
+	settingSMTPFromAddress    = "smtp_from_address"
+	settingSMTPFromName       = "smtp_from_name"
+	settingBackupDirectory    = "backup_directory"
+	settingBackupSchedule     = "backup_schedule"
+	settingBackupRetention    = "backup_retention"
+	settingExperimentalMode   = "experimental_mode"
+)
+
+// SettingsRepository handles all database interactions for application settings
+type SettingsRepository struct {
+	db Querier
+}
+
+// NewSettingsRepository creates a new settings repository
+func NewSettingsRepository(db Querier) *SettingsRepository {
+	return &SettingsRepository{db: db}
+}
+
+// Get returns the stored settings, falling back to defaults for keys never saved
+func (r *SettingsRepository) Get() (*models.Settings, error) {
+	// Execute the query
+	rows, err := r.db.Query(`SELECT key, value FROM settings`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	settings := models.DefaultSettings()
+	for rows.Next() {
+		var key, value string
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, err
+		}
+		if err := applySettingValue(settings, key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// Save stores all settings in a single transaction
+func (r *SettingsRepository) Save(settings *models.Settings) error {
+	// Validate settings
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := begin(r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO settings (key, value, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT(key) DO UPDATE SET value = excluded.value, updated_at = excluded.updated_at
+	`
+
+	// Execute the query for every key
+	now := time.Now()
+	for key, value := range settingValues(settings) {
+		if _, err := tx.Exec(query, key, value, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// settingValues encodes settings into their stored key/value form
+func settingValues(settings *models.Settings) map[string]string {
+	return map[string]string{
+		settingMarginalTaxRate:    strconv.FormatFloat(settings.MarginalTaxRate, 'f', -1, 64),
+		settingShowPostTaxFigures: strconv.FormatBool(settings.ShowPostTaxFigures),
+		settingSMTPHost:           settings.SMTPHost,
+		settingSMTPPort:           strconv.Itoa(settings.SMTPPort),
+		settingSMTPUsername:       settings.SMTPUsername,
+		settingSMTPPassword:       settings.SMTPPassword,
+		settingSMTPFromAddress:    settings.SMTPFromAddress,
+		settingSMTPFromName:       settings.SMTPFromName,
+		settingBackupDirectory:    settings.BackupDirectory,
+		settingBackupSchedule:     string(settings.BackupSchedule),
+		settingBackupRetention:    strconv.Itoa(settings.BackupRetention),
+		settingExperimentalMode:   strconv.FormatBool(settings.ExperimentalMode),
+	}
+}
+
+// applySettingValue decodes a stored value onto the settings; unknown keys are ignored
+func applySettingValue(settings *models.Settings, key, value string) error {
+	var err error
+	switch key {
+	case settingMarginalTaxRate:
+		settings.MarginalTaxRate, err = strconv.ParseFloat(value, 64)
+	case settingShowPostTaxFigures:
+		settings.ShowPostTaxFigures, err = strconv.ParseBool(value)
+	case settingSMTPHost:
+		settings.SMTPHost = value
+	case settingSMTPPort:
+		settings.SMTPPort, err = strconv.Atoi(value)
+	case settingSMTPUsername:
+		settings.SMTPUsername = value
+	case settingSMTPPassword:
+		settings.SMTPPassword = value
+	case settingSMTPFromAddress:
+		settings.SMTPFromAddress = value
+	case settingSMTPFromName:
+		settings.SMTPFromName = value
+	case settingBackupDirectory:
+		settings.BackupDirectory = value
+	case settingBackupSchedule:
+		settings.BackupSchedule = models.BackupSchedule(value)
+	case settingBackupRetention:
+		settings.BackupRetention, err = strconv.Atoi(value)
+	case settingExperimentalMode:
+		settings.ExperimentalMode, err = strconv.ParseBool(value)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value %q for setting %s: %w", value, key, err)
+	}
+	return nil
+}
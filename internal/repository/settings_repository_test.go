@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"testing"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func TestSettingsRepository_GetDefaults(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewSettingsRepository(db)
+
+	// Nothing stored yet
+	settings, err := repo.Get()
+	if err != nil {
+		t.Fatalf("Error getting settings: %v", err)
+	}
+
+	if *settings != *models.DefaultSettings() {
+		t.Errorf("Expected default settings, got %+v", settings)
+	}
+}
+
+func TestSettingsRepository_Save(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewSettingsRepository(db)
+
+	// Save custom settings
+	settings := models.DefaultSettings()
+	settings.MarginalTaxRate = 42
+	settings.ShowPostTaxFigures = true
+	settings.SMTPHost = "smtp.example.com"
+	settings.SMTPFromAddress = "vermieter@example.com"
+	if err := repo.Save(settings); err != nil {
+		t.Fatalf("Error saving settings: %v", err)
+	}
+
+	// Save again to exercise the upsert
+	settings.MarginalTaxRate = 35.5
+	if err := repo.Save(settings); err != nil {
+		t.Fatalf("Error updating settings: %v", err)
+	}
+
+	retrieved, err := repo.Get()
+	if err != nil {
+		t.Fatalf("Error getting settings: %v", err)
+	}
+	if *retrieved != *settings {
+		t.Errorf("Expected %+v, got %+v", settings, retrieved)
+	}
+
+	// Test out-of-range tax rate
+	settings.MarginalTaxRate = 120
+	if err := repo.Save(settings); err == nil {
+		t.Error("Expected error for tax rate above 100%, got nil")
+	}
+
+	// Test invalid sender once a server is configured
+	settings.MarginalTaxRate = 42
+	settings.SMTPFromAddress = "invalid"
+	if err := repo.Save(settings); err == nil {
+		t.Error("Expected error for invalid sender address, got nil")
+	}
+
+	// Test after-tax figures
+	settings.MarginalTaxRate = 42
+	if afterTax := settings.AfterTax(1000); afterTax != 580 {
+		t.Errorf("Expected 580 after tax, got %v", afterTax)
+	}
+	if afterTax := settings.AfterTax(-1000); afterTax != -580 {
+		t.Errorf("Expected -580 after tax, got %v", afterTax)
+	}
+}
+
+func TestSettingsRepository_BackupSchedule(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewSettingsRepository(db)
+
+	settings := models.DefaultSettings()
+	settings.BackupSchedule = models.BackupScheduleWeekly
+	settings.BackupRetention = 12
+	if err := repo.Save(settings); err != nil {
+		t.Fatalf("Error saving settings: %v", err)
+	}
+
+	retrieved, err := repo.Get()
+	if err != nil {
+		t.Fatalf("Error getting settings: %v", err)
+	}
+	if retrieved.BackupSchedule != models.BackupScheduleWeekly || retrieved.BackupRetention != 12 {
+		t.Errorf("Expected weekly schedule keeping 12 backups, got %+v", retrieved)
+	}
+
+	// Test invalid schedule and retention
+	settings.BackupSchedule = "hourly"
+	if err := repo.Save(settings); err == nil {
+		t.Error("Expected error for unknown schedule, got nil")
+	}
+	settings.BackupSchedule = models.BackupScheduleDaily
+	settings.BackupRetention = 0
+	if err := repo.Save(settings); err == nil {
+		t.Error("Expected error for zero retention, got nil")
+	}
+}
@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"property-management/internal/models"
+)
+
+// ErrLastAdmin is returned when a change would leave no administrator
+var ErrLastAdmin = errors.New("at least one administrator is required")
+
+// UserRepository handles all database interactions for user accounts
+type UserRepository struct {
+	db Querier
+}
+
+// NewUserRepository creates a new user repository
+func NewUserRepository(db Querier) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+// userColumns lists the columns selected for every user query, in scan order
+const userColumns = `id, username, password_hash, role, created_at, updated_at`
+
+// Create adds a new user to the database. The first user must be an administrator.
+func (r *UserRepository) Create(user *models.User) error {
+	// Validate user data
+	if err := user.Validate(); err != nil {
+		return err
+	}
+
+	// Ensure the installation cannot end up without an administrator
+	count, err := r.Count()
+	if err != nil {
+		return err
+	}
+	if count == 0 && user.Role != models.RoleAdmin {
+		return errors.New("the first user must be an administrator")
+	}
+
+	// Ensure the username is not taken
+	if _, err := r.GetByUsername(user.Username); err == nil {
+		return errors.New("username is already taken")
+	}
+
+	// Prepare the SQL statement
+	query := `
+		INSERT INTO users (username, password_hash, role, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	// Execute the query
+	now := time.Now()
+	result, err := r.db.Exec(query, user.Username, user.PasswordHash, user.Role, now, now)
+	if err != nil {
+		return err
+	}
+
+	// Get the inserted ID and update the user object
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	user.ID = id
+	user.CreatedAt = now
+	user.UpdatedAt = now
+
+	return nil
+}
+
+// Count returns the number of user accounts
+func (r *UserRepository) Count() (int, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&count)
+	return count, err
+}
+
+// GetAll returns all users ordered by username
+func (r *UserRepository) GetAll() ([]models.User, error) {
+	// Execute the query
+	rows, err := r.db.Query(`SELECT ` + userColumns + ` FROM users ORDER BY username`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Process the results
+	var users []models.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetByID returns a user with the specified ID
+func (r *UserRepository) GetByID(id int64) (*models.User, error) {
+	// Prepare the SQL statement
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = ?`
+
+	// Execute the query
+	user, err := scanUser(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("user not found")
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetByUsername returns the user with the specified username, ignoring case
+func (r *UserRepository) GetByUsername(username string) (*models.User, error) {
+	// Prepare the SQL statement
+	query := `SELECT ` + userColumns + ` FROM users WHERE username = ?`
+
+	// Execute the query
+	user, err := scanUser(r.db.QueryRow(query, strings.TrimSpace(username)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("user not found")
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// UpdateRole changes the role of a user, keeping at least one administrator
+func (r *UserRepository) UpdateRole(id int64, role models.Role) error {
+	// Validate role
+	if !role.IsValid() {
+		return errors.New("invalid role: must be admin, bookkeeper or viewer")
+	}
+
+	// Ensure user exists and the last administrator is not demoted
+	user, err := r.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if user.Role == models.RoleAdmin && role != models.RoleAdmin {
+		if err := r.ensureOtherAdmin(id); err != nil {
+			return err
+		}
+	}
+
+	// Prepare the SQL statement
+	query := `UPDATE users SET role = ?, updated_at = ? WHERE id = ?`
+
+	// Execute the query
+	_, err = r.db.Exec(query, role, time.Now(), id)
+	return err
+}
+
+// UpdatePassword replaces the password hash of a user
+func (r *UserRepository) UpdatePassword(id int64, passwordHash string) error {
+	// Ensure user exists
+	if _, err := r.GetByID(id); err != nil {
+		return err
+	}
+
+	// Prepare the SQL statement
+	query := `UPDATE users SET password_hash = ?, updated_at = ? WHERE id = ?`
+
+	// Execute the query
+	_, err := r.db.Exec(query, passwordHash, time.Now(), id)
+	return err
+}
+
+// Delete removes a user, keeping at least one administrator
+func (r *UserRepository) Delete(id int64) error {
+	// Ensure user exists and the last administrator is not removed
+	user, err := r.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if user.Role == models.RoleAdmin {
+		if err := r.ensureOtherAdmin(id); err != nil {
+			return err
+		}
+	}
+
+	// Prepare the SQL statement
+	query := `DELETE FROM users WHERE id = ?`
+
+	// Execute the query
+	_, err = r.db.Exec(query, id)
+	return err
+}
+
+// ensureOtherAdmin returns ErrLastAdmin unless an administrator other than id exists
+func (r *UserRepository) ensureOtherAdmin(id int64) error {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM users WHERE role = ? AND id != ?`, models.RoleAdmin, id).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrLastAdmin
+	}
+	return nil
+}
+
+// scanUser reads a single user from the given row
+func scanUser(row rowScanner) (*models.User, error) {
+	var user models.User
+	var createdAt, updatedAt string
+
+	err := row.Scan(
+		&user.ID,
+		&user.Username,
+		&user.PasswordHash,
+		&user.Role,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse timestamps
+	user.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	user.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+
+	return &user, nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"testing"
+
+	"property-management/internal/models"
+	"property-management/internal/testutil"
+)
+
+func newTestUser(username string, role models.Role) *models.User {
+	user := models.NewUser(username, role)
+	user.PasswordHash = "pbkdf2-sha256$1$c2FsdA$a2V5"
+	return user
+}
+
+func TestUserRepository_Create(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewUserRepository(db)
+
+	// The first user must be an administrator
+	if err := repo.Create(newTestUser("advisor", models.RoleViewer)); err == nil {
+		t.Error("Expected error for first user without admin role, got nil")
+	}
+
+	admin := newTestUser("owner", models.RoleAdmin)
+	if err := repo.Create(admin); err != nil {
+		t.Fatalf("Error creating admin: %v", err)
+	}
+	if admin.ID == 0 {
+		t.Error("Expected user ID to be set")
+	}
+
+	viewer := newTestUser("advisor", models.RoleViewer)
+	if err := repo.Create(viewer); err != nil {
+		t.Fatalf("Error creating viewer: %v", err)
+	}
+
+	// Usernames are unique regardless of case
+	if err := repo.Create(newTestUser("Owner", models.RoleViewer)); err == nil {
+		t.Error("Expected error for duplicate username, got nil")
+	}
+
+	// Test invalid role and missing password
+	if err := repo.Create(newTestUser("clerk", "clerk")); err == nil {
+		t.Error("Expected error for invalid role, got nil")
+	}
+	if err := repo.Create(models.NewUser("clerk", models.RoleViewer)); err == nil {
+		t.Error("Expected error for missing password, got nil")
+	}
+
+	// Lookup ignores case
+	retrieved, err := repo.GetByUsername("OWNER")
+	if err != nil {
+		t.Fatalf("Error getting user by name: %v", err)
+	}
+	if retrieved.ID != admin.ID || retrieved.PasswordHash != admin.PasswordHash {
+		t.Errorf("Expected %+v, got %+v", admin, retrieved)
+	}
+
+	users, err := repo.GetAll()
+	if err != nil {
+		t.Fatalf("Error getting users: %v", err)
+	}
+	if len(users) != 2 || users[0].Username != "advisor" {
+		t.Errorf("Expected 2 users ordered by name, got %+v", users)
+	}
+}
+
+func TestUserRepository_LastAdmin(t *testing.T) {
+	db := testutil.NewDB(t)
+
+	repo := NewUserRepository(db)
+
+	admin := newTestUser("owner", models.RoleAdmin)
+	if err := repo.Create(admin); err != nil {
+		t.Fatalf("Error creating admin: %v", err)
+	}
+	bookkeeper := newTestUser("bookkeeper", models.RoleBookkeeper)
+	if err := repo.Create(bookkeeper); err != nil {
+		t.Fatalf("Error creating bookkeeper: %v", err)
+	}
+
+	// The only administrator can neither be demoted nor deleted
+	if err := repo.UpdateRole(admin.ID, models.RoleViewer); err != ErrLastAdmin {
+		t.Errorf("Expected ErrLastAdmin when demoting, got %v", err)
+	}
+	if err := repo.Delete(admin.ID); err != ErrLastAdmin {
+		t.Errorf("Expected ErrLastAdmin when deleting, got %v", err)
+	}
+
+	// Once there is a second administrator, the first may go
+	if err := repo.UpdateRole(bookkeeper.ID, models.RoleAdmin); err != nil {
+		t.Fatalf("Error promoting user: %v", err)
+	}
+	if err := repo.Delete(admin.ID); err != nil {
+		t.Errorf("Error deleting admin: %v", err)
+	}
+	if _, err := repo.GetByID(admin.ID); err == nil {
+		t.Error("Expected deleted user to be gone")
+	}
+
+	// Test password update
+	if err := repo.UpdatePassword(bookkeeper.ID, "pbkdf2-sha256$1$bmV3$a2V5"); err != nil {
+		t.Fatalf("Error updating password: %v", err)
+	}
+	retrieved, err := repo.GetByID(bookkeeper.ID)
+	if err != nil {
+		t.Fatalf("Error getting user: %v", err)
+	}
+	if retrieved.PasswordHash != "pbkdf2-sha256$1$bmV3$a2V5" {
+		t.Errorf("Expected password hash to be updated, got %s", retrieved.PasswordHash)
+	}
+
+	// Test invalid role and non-existent user
+	if err := repo.UpdateRole(bookkeeper.ID, "owner"); err == nil {
+		t.Error("Expected error for invalid role, got nil")
+	}
+	if err := repo.Delete(9999); err == nil {
+		t.Error("Expected error for non-existent user, got nil")
+	}
+}
@@ -0,0 +1,32 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// Start runs job once right away and then on every tick of interval until
+// ctx is cancelled. Jobs decide themselves whether they are due, which keeps
+// schedules stable across application restarts. The returned channel is
+// closed once the first run has finished.
+func Start(ctx context.Context, interval time.Duration, job func()) <-chan struct{} {
+	firstRun := make(chan struct{})
+	go func() {
+		job()
+		close(firstRun)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				job()
+			}
+		}
+	}()
+
+	return firstRun
+}
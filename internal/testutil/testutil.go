@@ -0,0 +1,82 @@
+package testutil
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	dbpkg "property-management/internal/db"
+	"property-management/internal/models"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// NewDB returns an in-memory database with the full schema applied. It is
+// closed automatically when the test finishes.
+func NewDB(t testing.TB) *sql.DB {
+	t.Helper()
+
+	// Open the database connection
+	db, err := sql.Open("sqlite3", ":memory:?_foreign_keys=on")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+
+	// Every connection to :memory: is a separate database, so keep exactly one
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	// Create the schema
+	if err := dbpkg.Migrate(db); err != nil {
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+
+	return db
+}
+
+// CreateHouse inserts an active house with placeholder address data
+func CreateHouse(t testing.TB, db *sql.DB) *models.House {
+	t.Helper()
+
+	house := models.NewHouse("Test House", "Test Street", "123", "Test Country", "12345", "Test City")
+	now := time.Now()
+	result, err := db.Exec(
+		`INSERT INTO houses (name, street, number, country, zip_code, city, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		house.Name, house.Street, house.Number, house.Country, house.ZipCode, house.City, now, now,
+	)
+	if err != nil {
+		t.Fatalf("Error creating test house: %v", err)
+	}
+
+	house.ID, _ = result.LastInsertId()
+	house.CreatedAt = now
+	house.UpdatedAt = now
+	return house
+}
+
+// CreateMeter inserts a water meter into the given house
+func CreateMeter(t testing.TB, db *sql.DB, houseID int64) *models.Meter {
+	t.Helper()
+
+	meter := models.NewMeter(houseID, models.MeterTypeWater, "W-123", "Basement", "")
+	now := time.Now()
+	result, err := db.Exec(
+		`INSERT INTO meters (house_id, type, serial_number, location, unit, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		meter.HouseID, meter.Type, meter.SerialNumber, meter.Location, meter.Unit, now, now,
+	)
+	if err != nil {
+		t.Fatalf("Error creating test meter: %v", err)
+	}
+
+	meter.ID, _ = result.LastInsertId()
+	meter.CreatedAt = now
+	meter.UpdatedAt = now
+	return meter
+}
+
+// Date returns midnight UTC of the given day
+func Date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
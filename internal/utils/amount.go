@@ -0,0 +1,234 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// currencyMarkers are the currency symbols and codes ignored when parsing
+var currencyMarkers = []string{"EUR", "USD", "GBP", "CHF", "€", "$", "£"}
+
+// Locales understood by FormatAmount
+const (
+	LocaleGerman  = "de"
+	LocaleEnglish = "en"
+)
+
+// ParseError is returned when an amount cannot be parsed
+type ParseError struct {
+	Input  string
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("cannot parse amount %q: %s", e.Input, e.Reason)
+}
+
+// ParseAmount parses a monetary amount as typed by a user or pasted from a
+// bank statement. Both "1.234,56" and "1,234.56" are accepted, as are
+// currency symbols or codes and surrounding whitespace. When only a single
+// separator is present and it is followed by exactly three digits, it is
+// read as a thousands separator, since amounts never have three decimals.
+func ParseAmount(input string) (float64, error) {
+	fail := func(reason string) (float64, error) {
+		return 0, &ParseError{Input: input, Reason: reason}
+	}
+
+	s := strings.TrimSpace(input)
+	for _, marker := range currencyMarkers {
+		s = strings.ReplaceAll(s, marker, "")
+		s = strings.ReplaceAll(s, strings.ToLower(marker), "")
+	}
+
+	// Spaces, including non-breaking ones, only ever group thousands
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	// Extract the sign, which may come first or, as in some bank exports, last
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"), strings.HasPrefix(s, "−"):
+		negative = true
+		s = strings.TrimLeft(s, "-−")
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasSuffix(s, "-"):
+		negative = true
+		s = strings.TrimSuffix(s, "-")
+	}
+
+	if s == "" {
+		return fail("no digits")
+	}
+	for _, r := range s {
+		if (r < '0' || r > '9') && r != '.' && r != ',' {
+			return fail(fmt.Sprintf("unexpected character %q", r))
+		}
+	}
+
+	integer, fraction, err := splitAmount(s)
+	if err != nil {
+		return fail(err.Error())
+	}
+
+	normalized := integer
+	if fraction != "" {
+		normalized += "." + fraction
+	}
+	if negative {
+		normalized = "-" + normalized
+	}
+
+	value, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return fail("not a number")
+	}
+	return value, nil
+}
+
+// splitAmount separates the integer digits from the fraction digits of an
+// unsigned amount, removing thousands separators from the integer part
+func splitAmount(s string) (string, string, error) {
+	lastDot := strings.LastIndex(s, ".")
+	lastComma := strings.LastIndex(s, ",")
+
+	// Determine the decimal separator
+	var decimal byte
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		// With both present, whichever comes last separates the decimals
+		if lastDot > lastComma {
+			decimal = '.'
+		} else {
+			decimal = ','
+		}
+	case lastDot >= 0 || lastComma >= 0:
+		sep := byte('.')
+		if lastComma >= 0 {
+			sep = ','
+		}
+		index := strings.LastIndexByte(s, sep)
+		if strings.Count(s, string(sep)) == 1 && !isThousandsGroup(s[:index], s[index+1:]) {
+			decimal = sep
+		}
+	}
+
+	integer, fraction := s, ""
+	if decimal != 0 {
+		index := strings.LastIndexByte(s, decimal)
+		integer, fraction = s[:index], s[index+1:]
+		if strings.ContainsAny(fraction, ".,") {
+			return "", "", errors.New("misplaced separator")
+		}
+		if fraction == "" {
+			return "", "", errors.New("missing digits after decimal separator")
+		}
+	}
+
+	integer, err := removeThousandsSeparators(integer)
+	if err != nil {
+		return "", "", err
+	}
+	if integer == "" {
+		integer = "0"
+	}
+	return integer, fraction, nil
+}
+
+// isThousandsGroup reports whether a lone separator between head and tail
+// groups thousands rather than separating decimals
+func isThousandsGroup(head, tail string) bool {
+	return len(tail) == 3 && len(head) >= 1 && len(head) <= 3 && head != "0"
+}
+
+// removeThousandsSeparators strips grouping separators from the integer part
+// of an amount after checking that every group has three digits
+func removeThousandsSeparators(integer string) (string, error) {
+	if !strings.ContainsAny(integer, ".,") {
+		return integer, nil
+	}
+	if strings.Contains(integer, ".") && strings.Contains(integer, ",") {
+		return "", errors.New("mixed thousands separators")
+	}
+
+	groups := strings.FieldsFunc(integer, func(r rune) bool { return r == '.' || r == ',' })
+	if len(groups) != strings.Count(integer, ".")+strings.Count(integer, ",")+1 {
+		return "", errors.New("misplaced separator")
+	}
+	if len(groups[0]) > 3 {
+		return "", errors.New("misplaced thousands separator")
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return "", errors.New("misplaced thousands separator")
+		}
+	}
+	return strings.Join(groups, ""), nil
+}
+
+// FormatAmount renders a euro amount for display in the given locale, for
+// example "1.234,56 €" for German and "€1,234.56" for English. Locales are
+// matched by language, so "en-GB" formats as English; anything unknown falls
+// back to German. Amounts are rounded half away from zero on the cent as the
+// value would be written in decimal, so 1.005 becomes 1.01 even though the
+// nearest float is slightly below it.
+func FormatAmount(value float64, locale string) string {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	integer, fraction, negative := roundToCents(value)
+
+	thousands, decimal := ".", ","
+	english := strings.HasPrefix(strings.ToLower(locale), LocaleEnglish)
+	if english {
+		thousands, decimal = ",", "."
+	}
+
+	// Group the integer digits in threes from the right
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteString(thousands)
+		}
+		grouped.WriteRune(digit)
+	}
+	number := grouped.String() + decimal + fraction
+
+	sign := ""
+	if negative {
+		sign = "-"
+	}
+	if english {
+		return sign + "€" + number
+	}
+	return sign + number + " €"
+}
+
+// roundToCents rounds the shortest decimal representation of value half away
+// from zero to two decimals and returns its integer digits, its two fraction
+// digits and whether the rounded amount is negative
+func roundToCents(value float64) (string, string, bool) {
+	digits := strconv.FormatFloat(math.Abs(value), 'f', -1, 64)
+	integer, fraction, _ := strings.Cut(digits, ".")
+	fraction += "000"
+
+	cents, err := strconv.ParseInt(integer+fraction[:2], 10, 64)
+	if err != nil {
+		// Too large to count in cents; such amounts have no fraction left anyway
+		return integer, "00", value < 0
+	}
+	if fraction[2] >= '5' {
+		cents++
+	}
+
+	return strconv.FormatInt(cents/100, 10), fmt.Sprintf("%02d", cents%100), value < 0 && cents != 0
+}
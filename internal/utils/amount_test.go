@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	valid := map[string]float64{
+		"1234.56":       1234.56,
+		"1234,56":       1234.56,
+		"1.234,56":      1234.56,
+		"1,234.56":      1234.56,
+		"1.234.567,89":  1234567.89,
+		"1,234,567.89":  1234567.89,
+		"1 234,56":      1234.56,
+		"1\u00a0234,56": 1234.56,
+		"  42  ":        42,
+		"1.234":         1234,
+		"1,234":         1234,
+		"0,123":         0.123,
+		"12,5":          12.5,
+		",50":           0.5,
+		"1.234,56 €":    1234.56,
+		"€1,234.56":     1234.56,
+		"$ 99.99":       99.99,
+		"EUR 850,00":    850,
+		"850,00 eur":    850,
+		"-1.234,56 €":   -1234.56,
+		"€-12,00":       -12,
+		"12,00-":        -12,
+		"+5":            5,
+	}
+	for input, want := range valid {
+		got, err := ParseAmount(input)
+		if err != nil {
+			t.Errorf("ParseAmount(%q): unexpected error: %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseAmount(%q): expected %v, got %v", input, want, got)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"   ",
+		"€",
+		"abc",
+		"12a",
+		"1.23,45",
+		"1.234.56",
+		"12,34,56",
+		"1..234",
+		"1,234.",
+		"1.234,56.78",
+	}
+	for _, input := range invalid {
+		_, err := ParseAmount(input)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("ParseAmount(%q): expected ParseError, got %v", input, err)
+			continue
+		}
+		if parseErr.Input != input {
+			t.Errorf("ParseAmount(%q): expected input in error, got %q", input, parseErr.Input)
+		}
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	german := map[float64]string{
+		0:          "0,00 €",
+		5:          "5,00 €",
+		1234.56:    "1.234,56 €",
+		1234567.89: "1.234.567,89 €",
+		999.5:      "999,50 €",
+		-1234.5:    "-1.234,50 €",
+		123456:     "123.456,00 €",
+	}
+	for value, want := range german {
+		if got := FormatAmount(value, LocaleGerman); got != want {
+			t.Errorf("FormatAmount(%v, de): expected %q, got %q", value, want, got)
+		}
+	}
+
+	english := map[float64]string{
+		1234.56: "€1,234.56",
+		-0.5:    "-€0.50",
+		1000000: "€1,000,000.00",
+	}
+	for value, want := range english {
+		if got := FormatAmount(value, "en-GB"); got != want {
+			t.Errorf("FormatAmount(%v, en-GB): expected %q, got %q", value, want, got)
+		}
+	}
+
+	// Unknown locales fall back to German
+	if got := FormatAmount(1234.56, "fr"); got != "1.234,56 €" {
+		t.Errorf("Expected German fallback, got %q", got)
+	}
+}
+
+func TestFormatAmountRounding(t *testing.T) {
+	// Values whose nearest float lies just below the half cent still round up
+	rounding := map[float64]string{
+		1.005:      "1,01 €",
+		2.675:      "2,68 €",
+		0.125:      "0,13 €",
+		1.004:      "1,00 €",
+		999.995:    "1.000,00 €",
+		99999.999:  "100.000,00 €",
+		-1.005:     "-1,01 €",
+		-0.005:     "-0,01 €",
+		1234.5649:  "1.234,56 €",
+		1234.56501: "1.234,57 €",
+	}
+	for value, want := range rounding {
+		if got := FormatAmount(value, LocaleGerman); got != want {
+			t.Errorf("FormatAmount(%v): expected %q, got %q", value, want, got)
+		}
+	}
+
+	// Accumulated float error does not leak into the output
+	sum := 0.0
+	for i := 0; i < 10; i++ {
+		sum += 0.1
+	}
+	if got := FormatAmount(sum, LocaleGerman); got != "1,00 €" {
+		t.Errorf("Expected %q, got %q", "1,00 €", got)
+	}
+
+	// Amounts that round to zero are never shown as negative
+	if got := FormatAmount(-0.004, LocaleGerman); got != "0,00 €" {
+		t.Errorf("Expected %q, got %q", "0,00 €", got)
+	}
+}
+
+func TestFormatAmountRoundTrip(t *testing.T) {
+	for _, value := range []float64{0, 0.01, 12.3, 1234.56, -98765.43, 1234567.89} {
+		for _, locale := range []string{LocaleGerman, LocaleEnglish} {
+			formatted := FormatAmount(value, locale)
+			parsed, err := ParseAmount(formatted)
+			if err != nil {
+				t.Errorf("ParseAmount(%q): unexpected error: %v", formatted, err)
+				continue
+			}
+			if parsed != value {
+				t.Errorf("Round trip of %v via %q gave %v", value, formatted, parsed)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package vault
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Vault stores attached files in a directory tree grouped by entity
+type Vault struct {
+	root string
+}
+
+// New creates a vault rooted at the given directory
+func New(root string) *Vault {
+	return &Vault{root: root}
+}
+
+// Store copies the file at sourcePath into the vault and returns its path
+// relative to the vault root together with its size in bytes
+func (v *Vault) Store(sourcePath, entityType string, entityID int64) (string, int64, error) {
+	info, err := os.Stat(sourcePath)
+	if err != nil {
+		return "", 0, fmt.Errorf("file not accessible: %w", err)
+	}
+	if info.IsDir() {
+		return "", 0, errors.New("cannot store a directory")
+	}
+
+	// Prefix with random bytes so files with the same name never collide
+	prefix := make([]byte, 6)
+	if _, err := rand.Read(prefix); err != nil {
+		return "", 0, err
+	}
+
+	relPath := filepath.Join(entityType, fmt.Sprint(entityID), fmt.Sprintf("%x_%s", prefix, filepath.Base(sourcePath)))
+	targetPath := filepath.Join(v.root, relPath)
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0755); err != nil {
+		return "", 0, err
+	}
+
+	size, err := copyFile(sourcePath, targetPath)
+	if err != nil {
+		os.Remove(targetPath)
+		return "", 0, err
+	}
+
+	return filepath.ToSlash(relPath), size, nil
+}
+
+// Path resolves a relative vault path to an absolute one, refusing paths
+// that would escape the vault
+func (v *Vault) Path(relPath string) (string, error) {
+	root, err := filepath.Abs(v.root)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(root, filepath.FromSlash(relPath))
+	if !strings.HasPrefix(path, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid vault path %q", relPath)
+	}
+	return path, nil
+}
+
+// Remove deletes a stored file; files that are already gone are not an error
+func (v *Vault) Remove(relPath string) error {
+	path, err := v.Path(relPath)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Open opens the file with the operating system's default application
+func Open(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("file not accessible: %w", err)
+	}
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", path)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", path)
+	default:
+		cmd = exec.Command("xdg-open", path)
+	}
+	return cmd.Start()
+}
+
+// copyFile copies src to dst and returns the number of bytes written
+func copyFile(src, dst string) (int64, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := io.Copy(out, in)
+	if err != nil {
+		out.Close()
+		return 0, err
+	}
+
+	return size, out.Close()
+}
@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVault_StoreAndRemove(t *testing.T) {
+	v := New(t.TempDir())
+
+	// Create a source file
+	sourcePath := filepath.Join(t.TempDir(), "lease.pdf")
+	if err := os.WriteFile(sourcePath, []byte("lease contract"), 0644); err != nil {
+		t.Fatalf("Error writing source file: %v", err)
+	}
+
+	// Store it twice; both copies must be kept
+	first, size, err := v.Store(sourcePath, "house", 7)
+	if err != nil {
+		t.Fatalf("Error storing file: %v", err)
+	}
+	second, _, err := v.Store(sourcePath, "house", 7)
+	if err != nil {
+		t.Fatalf("Error storing file again: %v", err)
+	}
+
+	if first == second {
+		t.Error("Expected distinct paths for files with the same name")
+	}
+	if !strings.HasPrefix(first, "house/7/") || !strings.HasSuffix(first, "_lease.pdf") {
+		t.Errorf("Unexpected stored path %q", first)
+	}
+	if size != int64(len("lease contract")) {
+		t.Errorf("Expected size %d, got %d", len("lease contract"), size)
+	}
+
+	// The stored copy has the original content
+	path, err := v.Path(first)
+	if err != nil {
+		t.Fatalf("Error resolving stored path: %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil || string(content) != "lease contract" {
+		t.Errorf("Stored file has unexpected content %q (%v)", content, err)
+	}
+
+	// Remove it, twice
+	if err := v.Remove(first); err != nil {
+		t.Errorf("Error removing stored file: %v", err)
+	}
+	if err := v.Remove(first); err != nil {
+		t.Errorf("Expected removing a missing file to succeed, got %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("Expected stored file to be removed")
+	}
+}
+
+func TestVault_Invalid(t *testing.T) {
+	v := New(t.TempDir())
+
+	// Missing source file
+	if _, _, err := v.Store(filepath.Join(t.TempDir(), "missing.pdf"), "house", 1); err == nil {
+		t.Error("Expected error for missing source file, got nil")
+	}
+
+	// Directories cannot be stored
+	if _, _, err := v.Store(t.TempDir(), "house", 1); err == nil {
+		t.Error("Expected error for storing a directory, got nil")
+	}
+
+	// Paths may not escape the vault
+	if _, err := v.Path("../../etc/passwd"); err == nil {
+		t.Error("Expected error for path outside the vault, got nil")
+	}
+}